@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoArborescence is returned whenever the graph does not contain a
+// spanning arborescence rooted at the given vertex.
+var ErrNoArborescence = errors.New("no spanning arborescence exists")
+
+// weightedArc represents an arc between vertex positions, which
+// refers to an edge of the original graph by its position
+type weightedArc struct {
+	from, to int
+	weight   float64
+	edge     int
+}
+
+// chuLiuEdmonds returns the positions of the arcs, which form a
+// minimum spanning arborescence of the vertices in the range [0, n)
+// rooted at the given vertex. Each vertex other than the root must
+// have an incoming arc. Cycles formed by the cheapest incoming arcs
+// are contracted, and the arborescence of the contracted graph is
+// expanded by breaking each cycle at the vertex, where the
+// arborescence enters it.
+func chuLiuEdmonds(n, root int, arcs []weightedArc) []int {
+	// The cheapest incoming arc of each vertex
+	in := make([]int, n)
+	for v := range in {
+		in[v] = -1
+	}
+	for i, a := range arcs {
+		if a.from != a.to && a.to != root && (in[a.to] == -1 || a.weight < arcs[in[a.to]].weight) {
+			in[a.to] = i
+		}
+	}
+
+	// Find the cycles formed by the cheapest incoming arcs
+	comp := make([]int, n)
+	visit := make([]int, n)
+	for v := range comp {
+		comp[v] = -1
+		visit[v] = -1
+	}
+	cycles := make([][]int, 0)
+	for start := 0; start < n; start++ {
+		v := start
+		for v != root && visit[v] == -1 && comp[v] == -1 {
+			visit[v] = start
+			v = arcs[in[v]].from
+		}
+		if v != root && visit[v] == start && comp[v] == -1 {
+			// Walked into a cycle for the first time
+			cycle := []int{v}
+			comp[v] = len(cycles)
+			for u := arcs[in[v]].from; u != v; u = arcs[in[u]].from {
+				cycle = append(cycle, u)
+				comp[u] = len(cycles)
+			}
+			cycles = append(cycles, cycle)
+		}
+	}
+
+	if len(cycles) == 0 {
+		result := make([]int, 0, n-1)
+		for v := 0; v < n; v++ {
+			if v != root {
+				result = append(result, in[v])
+			}
+		}
+		return result
+	}
+
+	// The contracted vertices come after the vertices, which are
+	// not part of any cycle
+	count := len(cycles)
+	for v := 0; v < n; v++ {
+		if comp[v] == -1 {
+			comp[v] = count
+			count++
+		}
+	}
+
+	// Arcs entering a cycle are charged with the weight of the
+	// cycle arc they replace
+	contracted := make([]weightedArc, 0, len(arcs))
+	origin := make([]int, 0, len(arcs))
+	for i, a := range arcs {
+		from, to := comp[a.from], comp[a.to]
+		if from == to {
+			continue
+		}
+		weight := a.weight
+		if to < len(cycles) {
+			weight -= arcs[in[a.to]].weight
+		}
+		contracted = append(contracted, weightedArc{from: from, to: to, weight: weight, edge: a.edge})
+		origin = append(origin, i)
+	}
+
+	chosen := chuLiuEdmonds(count, comp[root], contracted)
+
+	result := make([]int, 0, n-1)
+	entered := make([]int, len(cycles))
+	for _, i := range chosen {
+		a := arcs[origin[i]]
+		result = append(result, origin[i])
+		if c := comp[a.to]; c < len(cycles) {
+			entered[c] = a.to
+		}
+	}
+	for c, cycle := range cycles {
+		for _, v := range cycle {
+			if v != entered[c] {
+				result = append(result, in[v])
+			}
+		}
+	}
+
+	return result
+}
+
+// MinimumArborescence returns a minimum spanning arborescence of the
+// directed graph rooted at the given vertex, using the algorithm of
+// Chu, Liu and Edmonds in O(n * m) time. An arborescence contains a
+// single path from the root to each other vertex, and a minimum one
+// has the least total edge weight. The weights and attributes of the
+// vertices and edges are preserved. A maximum spanning arborescence
+// can be found by negating the edge weights.
+//
+// Returns ErrNoArborescence, if some vertex is not reachable from the
+// root.
+func MinimumArborescence[T comparable](g Graph[T], root T) (Graph[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	if !g.VertexExists(root) {
+		return nil, fmt.Errorf("Root vertex %v not found in the graph", root)
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	edges := g.GetEdges()
+	arcs := make([]weightedArc, 0, len(edges))
+	adj := make([][]int, n)
+	for k, e := range edges {
+		from, to := idx.index[e.From], idx.index[e.To]
+		arcs = append(arcs, weightedArc{from: from, to: to, weight: e.Weight, edge: k})
+		adj[from] = append(adj[from], to)
+	}
+
+	// Every vertex must be reachable from the root. The search is
+	// done over the positions, so that the vertex attributes are
+	// left intact.
+	reached := make([]bool, n)
+	reached[idx.index[root]] = true
+	stack := []int{idx.index[root]}
+	count := 1
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, u := range adj[v] {
+			if !reached[u] {
+				reached[u] = true
+				count++
+				stack = append(stack, u)
+			}
+		}
+	}
+	if count != n {
+		return nil, fmt.Errorf("%w: not all vertices are reachable from %v", ErrNoArborescence, root)
+	}
+
+	keep := make(map[*Edge[T]]bool)
+	for _, i := range chuLiuEdmonds(n, idx.index[root], arcs) {
+		keep[edges[arcs[i].edge]] = true
+	}
+	keepVertex := func(v T) bool {
+		return true
+	}
+	keepEdge := func(e *Edge[T]) bool {
+		return keep[e]
+	}
+
+	return filteredSubgraph(g, keepVertex, keepEdge), nil
+}
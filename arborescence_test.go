@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the given graph is a spanning
+// arborescence of g rooted at the given vertex
+func verifyArborescence(t *testing.T, g, tree graph.Graph[int], root int) {
+	t.Helper()
+
+	if tree.Kind() != graph.KindDirected {
+		t.Fatal("arborescence is not a directed graph")
+	}
+	if len(tree.GetVertices()) != len(g.GetVertices()) {
+		t.Fatalf("want %d vertices, got %d", len(g.GetVertices()), len(tree.GetVertices()))
+	}
+
+	parent := make(map[int]int)
+	for _, e := range tree.GetEdges() {
+		if !g.EdgeExists(e.From, e.To) || g.GetEdge(e.From, e.To).Weight != e.Weight {
+			t.Fatalf("edge %v -> %v is not an edge of the graph", e.From, e.To)
+		}
+		if _, ok := parent[e.To]; ok || e.To == root {
+			t.Fatalf("vertex %v has more than one parent", e.To)
+		}
+		parent[e.To] = e.From
+	}
+
+	for _, v := range g.GetVertexValues() {
+		steps := 0
+		for v != root {
+			u, ok := parent[v]
+			if !ok || steps > len(parent) {
+				t.Fatalf("vertex %v is not connected to the root", v)
+			}
+			v = u
+			steps++
+		}
+	}
+}
+
+// A helper function which returns the weight of a minimum spanning
+// arborescence by trying each choice of incoming edges
+func bruteForceArborescence(g graph.Graph[int], root int) float64 {
+	vertices := g.GetVertexValues()
+	incoming := make(map[int][]*graph.Edge[int])
+	for _, e := range g.GetEdges() {
+		if e.From != e.To && e.To != root {
+			incoming[e.To] = append(incoming[e.To], e)
+		}
+	}
+
+	best := math.Inf(1)
+	parent := make(map[int]int)
+	var choose func(i int, weight float64)
+	choose = func(i int, weight float64) {
+		if i == len(vertices) {
+			for _, v := range vertices {
+				for steps := 0; v != root; steps++ {
+					if steps > len(vertices) {
+						return
+					}
+					v = parent[v]
+				}
+			}
+			best = min(best, weight)
+			return
+		}
+		if vertices[i] == root {
+			choose(i+1, weight)
+			return
+		}
+		for _, e := range incoming[vertices[i]] {
+			parent[e.To] = e.From
+			choose(i+1, weight+e.Weight)
+		}
+	}
+	choose(0, 0)
+
+	return best
+}
+
+func TestMinimumArborescence(t *testing.T) {
+	// Undirected graphs are not supported
+	g1 := graph.New[int](graph.KindUndirected)
+	g1.AddEdge(1, 2)
+	if _, err := graph.MinimumArborescence(g1, 1); err != graph.ErrIsNotDirectedGraph {
+		t.Fatalf("want ErrIsNotDirectedGraph, got %v", err)
+	}
+
+	// The cheapest incoming edges of 2 and 3 form a cycle, which
+	// must be entered from the root
+	g2 := graph.New[int](graph.KindDirected)
+	g2.AddWeightedEdge(1, 2, 10)
+	g2.AddWeightedEdge(1, 3, 4)
+	g2.AddWeightedEdge(2, 3, 1)
+	g2.AddWeightedEdge(3, 2, 1)
+	g2.AddWeightedEdge(3, 3, -5)
+	g2.AddWeightedEdge(2, 4, 2)
+	g2.AddWeightedEdge(4, 1, 1)
+	g2.GetEdge(1, 3).DotAttributes["color"] = "red"
+	g2.GetVertex(4).DotAttributes["shape"] = "box"
+
+	// The vertex attributes of the graph are left intact
+	g2.GetVertex(2).Color = graph.Gray
+	g2.GetVertex(2).Parent = g2.GetVertex(4)
+	g2.GetVertex(2).DistanceFromSource = 42
+
+	tree, err := graph.MinimumArborescence(g2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := g2.GetVertex(2); v.Color != graph.Gray || v.Parent != g2.GetVertex(4) || v.DistanceFromSource != 42 {
+		t.Fatal("vertex attributes must not be modified")
+	}
+	verifyArborescence(t, g2, tree, 1)
+	if got := totalWeight(tree.GetEdges()); got != 7 {
+		t.Fatalf("want weight 7, got %v", got)
+	}
+	if !tree.EdgeExists(1, 3) || tree.GetEdge(1, 3).DotAttributes["color"] != "red" {
+		t.Fatal("edge attributes are not preserved")
+	}
+	if tree.GetVertex(4).DotAttributes["shape"] != "box" {
+		t.Fatal("vertex attributes are not preserved")
+	}
+
+	// Vertex 5 is not reachable from the root
+	g2.AddVertex(5)
+	if _, err := graph.MinimumArborescence(g2, 1); !errors.Is(err, graph.ErrNoArborescence) {
+		t.Fatalf("want ErrNoArborescence, got %v", err)
+	}
+
+	// Unknown root vertex
+	if _, err := graph.MinimumArborescence(g2, 42); err == nil {
+		t.Fatal("expected error for unknown root vertex")
+	}
+}
+
+func TestMinimumArborescenceRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(47))
+	for i := 0; i < 200; i++ {
+		n := r.Intn(7) + 1
+		g := newRandomDirectedGraph(r, n, 0.45, false)
+		root := r.Intn(n)
+
+		want := bruteForceArborescence(g, root)
+		tree, err := graph.MinimumArborescence(g, root)
+		if math.IsInf(want, 1) {
+			if !errors.Is(err, graph.ErrNoArborescence) {
+				t.Fatalf("graph %d: want ErrNoArborescence, got %v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("graph %d: %v", i, err)
+		}
+
+		verifyArborescence(t, g, tree, root)
+		if got := totalWeight(tree.GetEdges()); got != want {
+			t.Fatalf("graph %d: want weight %v, got %v", i, want, got)
+		}
+	}
+}
@@ -34,6 +34,18 @@ import (
 // WalkBFS performs Breadth-first Search (BFS) traversal of the graph,
 // starting from the given source vertex.
 func WalkBFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
+	return WalkBFSWithOptions(g, source, nil, walkFunc)
+}
+
+// WalkBFSWithOptions performs Breadth-first Search (BFS) traversal of
+// the graph, starting from the given source vertex, and bounded by the
+// given options. When the options are nil, the default options are
+// used.
+func WalkBFSWithOptions[T comparable](g Graph[T], source T, opts *WalkOptions, walkFunc WalkFunc[T]) error {
+	if opts == nil {
+		opts = DefaultWalkOptions()
+	}
+
 	if !g.VertexExists(source) {
 		return fmt.Errorf("Source vertex %v not found in the graph", source)
 	}
@@ -54,8 +66,11 @@ func WalkBFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
 			panic(err)
 		}
 
-		// Visit neighbours of V
+		// Visit neighbours of V, unless it is at the maximum depth
 		neighbours := g.GetNeighbourVertices(v.Value)
+		if !opts.canExplore(v.DistanceFromSource) {
+			neighbours = nil
+		}
 		for _, u := range neighbours {
 			// First time seeing this vertex
 			if u.Color == White {
@@ -80,3 +95,33 @@ func WalkBFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
 
 	return nil
 }
+
+// Neighbourhood returns the ego-graph of the given vertex, which is
+// the subgraph induced by the vertices within the given number of
+// edges from it. The edges of a directed graph are followed in their
+// direction only. The weights and attributes of the vertices and
+// edges are preserved.
+func Neighbourhood[T comparable](g Graph[T], v T, k int) (Graph[T], error) {
+	if k < 0 {
+		return nil, fmt.Errorf("Invalid number of hops %d", k)
+	}
+	if !g.VertexExists(v) {
+		return nil, fmt.Errorf("Vertex %v not found in the graph", v)
+	}
+
+	keep := make(map[T]bool)
+	opts := DefaultWalkOptions()
+	opts.MaxDepth = k
+	walkFunc := func(u *Vertex[T]) error {
+		keep[u.Value] = true
+		return nil
+	}
+	if err := WalkBFSWithOptions(g, v, opts, walkFunc); err != nil {
+		return nil, err
+	}
+	keepVertex := func(u T) bool {
+		return keep[u]
+	}
+
+	return inducedSubgraph(g, keepVertex), nil
+}
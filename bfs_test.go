@@ -27,6 +27,7 @@ package graph_test
 
 import (
 	"errors"
+	"math/rand"
 	"slices"
 	"testing"
 
@@ -145,3 +146,104 @@ func TestWalkBFS(t *testing.T) {
 		t.Fatal("WalkBFS is expected to return our custom error")
 	}
 }
+
+func TestWalkBFSWithOptions(t *testing.T) {
+	r := rand.New(rand.NewSource(50))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(30) + 1
+		var g graph.Graph[int]
+		if i%2 == 0 {
+			g = newRandomDirectedGraph(r, n, 0.1, false)
+		} else {
+			g = newRandomUndirectedGraph(r, n, 0.1)
+		}
+		source := r.Intn(n)
+		hops := walkDistances(g, source, graph.WalkBFS[int])
+
+		maxDepth := r.Intn(4)
+		opts := &graph.WalkOptions{MaxDepth: maxDepth}
+		visited := make(map[int]bool)
+		walkFunc := func(v *graph.Vertex[int]) error {
+			visited[v.Value] = true
+			if v.DistanceFromSource != hops[v.Value] {
+				t.Fatalf("graph %d: vertex %v: want depth %v, got %v", i, v.Value, hops[v.Value], v.DistanceFromSource)
+			}
+			return nil
+		}
+		if err := graph.WalkBFSWithOptions(g, source, opts, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		for v, d := range hops {
+			if visited[v] != (d <= float64(maxDepth)) {
+				t.Fatalf("graph %d: vertex %v at depth %v walked %v with max depth %d", i, v, d, visited[v], maxDepth)
+			}
+		}
+
+		// The neighbourhood contains the walked vertices and the
+		// edges between them
+		ego, err := graph.Neighbourhood(g, source, maxDepth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ego.GetVertices()) != len(visited) {
+			t.Fatalf("graph %d: want %d vertices, got %d", i, len(visited), len(ego.GetVertices()))
+		}
+		wantEdges := 0
+		for _, e := range g.GetEdges() {
+			if visited[e.From] && visited[e.To] {
+				wantEdges++
+				if !ego.EdgeExists(e.From, e.To) {
+					t.Fatalf("graph %d: missing edge %v-%v", i, e.From, e.To)
+				}
+			}
+		}
+		if len(ego.GetEdges()) != wantEdges {
+			t.Fatalf("graph %d: want %d edges, got %d", i, wantEdges, len(ego.GetEdges()))
+		}
+	}
+}
+
+func TestNeighbourhood(t *testing.T) {
+	g := newPathGraph()
+	g.GetVertex(2).DotAttributes["color"] = "red"
+
+	ego, err := graph.Neighbourhood(g, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotVertices := ego.GetVertexValues()
+	slices.Sort(gotVertices)
+	if !slices.Equal([]int{1, 2, 3}, gotVertices) {
+		t.Fatalf("want vertices [1 2 3], got %v", gotVertices)
+	}
+	if ego.GetVertex(2).DotAttributes["color"] != "red" {
+		t.Fatal("vertex attributes are not preserved")
+	}
+
+	// Zero hops contain the vertex only
+	ego, err = graph.Neighbourhood(g, 2, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal([]int{2}, ego.GetVertexValues()) || len(ego.GetEdges()) != 0 {
+		t.Fatal("want a single vertex")
+	}
+
+	// A depth of zero walks the source vertex only
+	opts := graph.DefaultWalkOptions()
+	opts.MaxDepth = 0
+	collector := g.NewCollector()
+	if err := graph.WalkBFSWithOptions(g, 2, opts, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if got := collector.Get(); len(got) != 1 || got[0].Value != 2 {
+		t.Fatalf("want the source vertex only, got %v", got)
+	}
+
+	if _, err := graph.Neighbourhood(g, 42, 1); err == nil {
+		t.Fatal("expected error for unknown vertex")
+	}
+	if _, err := graph.Neighbourhood(g, 2, -1); err == nil {
+		t.Fatal("expected error for negative number of hops")
+	}
+}
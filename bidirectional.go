@@ -0,0 +1,246 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"gopkg.in/dnaeon/go-priorityqueue.v1"
+)
+
+// searchSide represents one of the two searches of a bidirectional
+// search. The forward search follows the edges of the graph from the
+// source vertex, and the backward search follows them in reverse
+// from the destination vertex.
+type searchSide[T comparable] struct {
+	// The neighbours of a vertex in the direction of the search
+	next func(v T) []T
+
+	// The weight of the edge between a vertex and its neighbour in
+	// the direction of the search
+	weight func(v, u T) float64
+
+	// Distance and parent of each vertex discovered by the search
+	dist   map[T]float64
+	parent map[T]T
+}
+
+// newSearchSides returns the forward and backward searches between
+// the given source and destination vertices. The backward search of
+// a directed graph uses the reverse adjacency of the graph.
+func newSearchSides[T comparable](g Graph[T], source, dest T) (*searchSide[T], *searchSide[T]) {
+	forward := &searchSide[T]{
+		next: g.GetNeighbours,
+		weight: func(v, u T) float64 {
+			return g.GetEdge(v, u).Weight
+		},
+		dist:   map[T]float64{source: 0},
+		parent: make(map[T]T),
+	}
+	backward := &searchSide[T]{
+		next:   forward.next,
+		weight: forward.weight,
+		dist:   map[T]float64{dest: 0},
+		parent: make(map[T]T),
+	}
+
+	if g.Kind() == KindDirected {
+		reverse := make(map[T][]T)
+		for _, e := range g.GetEdges() {
+			reverse[e.To] = append(reverse[e.To], e.From)
+		}
+		backward.next = func(v T) []T {
+			return reverse[v]
+		}
+		backward.weight = func(v, u T) float64 {
+			return g.GetEdge(u, v).Weight
+		}
+	}
+
+	return forward, backward
+}
+
+// joinPaths returns the path from the source vertex to the
+// destination vertex, which passes through the vertex where the
+// forward and backward searches meet.
+func joinPaths[T comparable](forward, backward *searchSide[T], meet T) []T {
+	path := []T{meet}
+	for v, ok := forward.parent[meet]; ok; v, ok = forward.parent[v] {
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	for v, ok := backward.parent[meet]; ok; v, ok = backward.parent[v] {
+		path = append(path, v)
+	}
+
+	return path
+}
+
+// validateEndpoints returns an error if the source or destination
+// vertex is not part of the graph
+func validateEndpoints[T comparable](g Graph[T], source, dest T) error {
+	if !g.VertexExists(source) {
+		return fmt.Errorf("Source vertex %v not found in the graph", source)
+	}
+	if !g.VertexExists(dest) {
+		return fmt.Errorf("Destination vertex %v not found in the graph", dest)
+	}
+
+	return nil
+}
+
+// BidirectionalBFS returns a path between the source and destination
+// vertices with the least number of edges, along with the number of
+// edges in the path. The search alternates between a breadth-first
+// search from the source vertex and a breadth-first search over the
+// reversed edges from the destination vertex, always expanding the
+// smaller frontier, and stops as soon as the two searches meet.
+func BidirectionalBFS[T comparable](g Graph[T], source, dest T) ([]T, int, error) {
+	if err := validateEndpoints(g, source, dest); err != nil {
+		return nil, 0, err
+	}
+	if source == dest {
+		return []T{source}, 0, nil
+	}
+
+	forward, backward := newSearchSides(g, source, dest)
+	frontiers := [2][]T{{source}, {dest}}
+	sides := [2]*searchSide[T]{forward, backward}
+	for len(frontiers[0]) > 0 && len(frontiers[1]) > 0 {
+		// Expand the smaller frontier by a whole level, so that
+		// the shortest path through the level is found
+		i := 0
+		if len(frontiers[1]) < len(frontiers[0]) {
+			i = 1
+		}
+		side, other := sides[i], sides[1-i]
+
+		best := math.Inf(1)
+		var meet T
+		level := make([]T, 0)
+		for _, v := range frontiers[i] {
+			for _, u := range side.next(v) {
+				if _, ok := side.dist[u]; ok {
+					continue
+				}
+				side.dist[u] = side.dist[v] + 1
+				side.parent[u] = v
+				level = append(level, u)
+				if d, ok := other.dist[u]; ok && side.dist[u]+d < best {
+					best = side.dist[u] + d
+					meet = u
+				}
+			}
+		}
+		if !math.IsInf(best, 1) {
+			return joinPaths(forward, backward, meet), int(best), nil
+		}
+		frontiers[i] = level
+	}
+
+	return nil, 0, fmt.Errorf("No path exists between %v and %v", source, dest)
+}
+
+// BidirectionalDijkstra returns a shortest path between the source and
+// destination vertices, along with the total weight of the path. The
+// search alternates between Dijkstra's algorithm from the source
+// vertex and Dijkstra's algorithm over the reversed edges from the
+// destination vertex, and stops once no path through the unsettled
+// vertices can be shorter than the best path found so far. The edge
+// weights must not be negative.
+func BidirectionalDijkstra[T comparable](g Graph[T], source, dest T) ([]T, float64, error) {
+	if err := validateEndpoints(g, source, dest); err != nil {
+		return nil, 0, err
+	}
+
+	forward, backward := newSearchSides(g, source, dest)
+	sides := [2]*searchSide[T]{forward, backward}
+	queues := [2]*priorityqueue.PriorityQueue[T, float64]{
+		priorityqueue.New[T, float64](priorityqueue.MinHeap),
+		priorityqueue.New[T, float64](priorityqueue.MinHeap),
+	}
+	queues[0].Put(source, 0)
+	queues[1].Put(dest, 0)
+	queued := [2]map[T]bool{{source: true}, {dest: true}}
+	settled := [2]map[T]bool{make(map[T]bool), make(map[T]bool)}
+
+	// The distances of the last settled vertices never decrease,
+	// and their sum bounds the length of any path not found yet
+	last := [2]float64{0, 0}
+	best := math.Inf(1)
+	meet := source
+	if source == dest {
+		best = 0
+	}
+
+	for i := 0; !queues[0].IsEmpty() && !queues[1].IsEmpty(); i = 1 - i {
+		if last[0]+last[1] >= best {
+			break
+		}
+		side, other := sides[i], sides[1-i]
+		item := queues[i].Get()
+		v := item.Value
+		queued[i][v] = false
+		settled[i][v] = true
+		last[i] = item.Priority
+
+		for _, u := range side.next(v) {
+			if settled[i][u] {
+				continue
+			}
+			w := side.weight(v, u)
+			if w < 0 {
+				if i == 1 {
+					v, u = u, v
+				}
+				return nil, 0, fmt.Errorf("Negative weight for edge %v-%v", v, u)
+			}
+			alt := side.dist[v] + w
+			if d, ok := side.dist[u]; !ok || alt < d {
+				side.dist[u] = alt
+				side.parent[u] = v
+				if queued[i][u] {
+					queues[i].Update(u, alt)
+				} else {
+					queues[i].Put(u, alt)
+					queued[i][u] = true
+				}
+			}
+			if d, ok := other.dist[u]; ok && side.dist[u]+d < best {
+				best = side.dist[u] + d
+				meet = u
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		return nil, 0, fmt.Errorf("No path exists between %v and %v", source, dest)
+	}
+
+	return joinPaths(forward, backward, meet), best, nil
+}
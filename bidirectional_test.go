@@ -0,0 +1,171 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the given path connects the
+// source and destination vertices, and returns its total weight
+func verifyPathWeight(t *testing.T, g graph.Graph[int], path []int, source, dest int) float64 {
+	t.Helper()
+
+	if len(path) == 0 || path[0] != source || path[len(path)-1] != dest {
+		t.Fatalf("path %v does not connect %v and %v", path, source, dest)
+	}
+
+	weight := 0.0
+	for i := 1; i < len(path); i++ {
+		if !g.EdgeExists(path[i-1], path[i]) {
+			t.Fatalf("path %v uses missing edge %v -> %v", path, path[i-1], path[i])
+		}
+		weight += g.GetEdge(path[i-1], path[i]).Weight
+	}
+
+	return weight
+}
+
+// A helper function which returns the distances from the source
+// vertex as computed by the given walk function
+func walkDistances(g graph.Graph[int], source int, walk func(graph.Graph[int], int, graph.WalkFunc[int]) error) map[int]float64 {
+	dist := make(map[int]float64)
+	walkFunc := func(v *graph.Vertex[int]) error {
+		return nil
+	}
+	if err := walk(g, source, walkFunc); err != nil {
+		panic(err)
+	}
+	for _, v := range g.GetVertices() {
+		if v.Value == source || v.Parent != nil {
+			dist[v.Value] = v.DistanceFromSource
+		}
+	}
+
+	return dist
+}
+
+func TestBidirectionalSearch(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 1)
+	g.AddWeightedEdge(3, 4, 1)
+	g.AddWeightedEdge(1, 4, 10)
+	g.AddVertex(5)
+
+	path, hops, err := graph.BidirectionalBFS(g, 1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hops != 1 || len(path) != 2 {
+		t.Fatalf("want a single edge path, got %v", path)
+	}
+
+	path, cost, err := graph.BidirectionalDijkstra(g, 1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost != 3 || len(path) != 4 {
+		t.Fatalf("want path 1-2-3-4 of cost 3, got %v of cost %v", path, cost)
+	}
+
+	// The edges must not be followed backwards
+	if _, _, err := graph.BidirectionalBFS(g, 4, 1); err == nil {
+		t.Fatal("expected error for missing path")
+	}
+	if _, _, err := graph.BidirectionalDijkstra(g, 4, 1); err == nil {
+		t.Fatal("expected error for missing path")
+	}
+	if _, _, err := graph.BidirectionalDijkstra(g, 1, 5); err == nil {
+		t.Fatal("expected error for missing path")
+	}
+
+	// Source and destination are the same vertex
+	path, cost, err = graph.BidirectionalDijkstra(g, 5, 5)
+	if err != nil || cost != 0 || len(path) != 1 {
+		t.Fatalf("want trivial path, got %v of cost %v (%v)", path, cost, err)
+	}
+
+	// Unknown vertices
+	if _, _, err := graph.BidirectionalBFS(g, 1, 42); err == nil {
+		t.Fatal("expected error for unknown destination vertex")
+	}
+	if _, _, err := graph.BidirectionalDijkstra(g, 42, 1); err == nil {
+		t.Fatal("expected error for unknown source vertex")
+	}
+
+	// Negative weights are not supported
+	g.AddWeightedEdge(4, 5, -1)
+	if _, _, err := graph.BidirectionalDijkstra(g, 1, 5); err == nil {
+		t.Fatal("expected error for negative weight")
+	}
+}
+
+func TestBidirectionalSearchRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(48))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(30) + 1
+		var g graph.Graph[int]
+		if i%2 == 0 {
+			g = newRandomDirectedGraph(r, n, 0.1, false)
+		} else {
+			g = newRandomUndirectedGraph(r, n, 0.1)
+		}
+
+		source := r.Intn(n)
+		hops := walkDistances(g, source, graph.WalkBFS[int])
+		dist := walkDistances(g, source, graph.WalkDijkstra[int])
+		for dest := 0; dest < n; dest++ {
+			path, gotHops, err := graph.BidirectionalBFS(g, source, dest)
+			if _, ok := hops[dest]; !ok {
+				if err == nil {
+					t.Fatalf("graph %d: want no path between %v and %v, got %v", i, source, dest, path)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("graph %d: %v", i, err)
+			}
+			verifyPathWeight(t, g, path, source, dest)
+			if float64(gotHops) != hops[dest] || len(path) != gotHops+1 {
+				t.Fatalf("graph %d: want %v edges between %v and %v, got %v", i, hops[dest], source, dest, path)
+			}
+
+			path, cost, err := graph.BidirectionalDijkstra(g, source, dest)
+			if err != nil {
+				t.Fatalf("graph %d: %v", i, err)
+			}
+			weight := verifyPathWeight(t, g, path, source, dest)
+			if math.Abs(weight-cost) > 1e-9 || math.Abs(cost-dist[dest]) > 1e-9 {
+				t.Fatalf("graph %d: want cost %v between %v and %v, got %v (path weight %v)", i, dist[dest], source, dest, cost, weight)
+			}
+		}
+	}
+}
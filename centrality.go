@@ -0,0 +1,586 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+
+	"gopkg.in/dnaeon/go-deque.v1"
+	"gopkg.in/dnaeon/go-priorityqueue.v1"
+)
+
+// CentralityOptions represents the options used when computing the
+// centrality of the vertices in a graph.
+type CentralityOptions struct {
+	// Weighted specifies whether shortest paths are computed
+	// using the edge weights, or by counting the number of hops.
+	// When computing eigenvector and Katz centrality it specifies
+	// whether the adjacency matrix contains the edge weights.
+	Weighted bool
+
+	// Normalized specifies whether the results are normalized.
+	Normalized bool
+
+	// Workers is the number of goroutines used when computing
+	// shortest paths from each source vertex. When zero, the value
+	// of runtime.GOMAXPROCS is used.
+	Workers int
+
+	// Tolerance is the error tolerance used to check for
+	// convergence of eigenvector and Katz centrality.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations used when
+	// computing eigenvector and Katz centrality.
+	MaxIterations int
+
+	// Alpha is the attenuation factor used by Katz centrality.
+	Alpha float64
+
+	// Beta is the weight attributed to the immediate neighbourhood
+	// by Katz centrality.
+	Beta float64
+}
+
+// DefaultCentralityOptions returns the default options for computing
+// centrality.
+func DefaultCentralityOptions() *CentralityOptions {
+	opts := &CentralityOptions{
+		Weighted:      false,
+		Normalized:    true,
+		Tolerance:     1.0e-6,
+		MaxIterations: 100,
+		Alpha:         0.1,
+		Beta:          1.0,
+	}
+
+	return opts
+}
+
+// shortestPaths contains the result of computing the shortest paths
+// from a single source vertex, in terms of vertex positions.
+type shortestPaths struct {
+	// The vertices in order of non-decreasing distance from the
+	// source
+	order []int
+
+	// The distance of each vertex from the source, or +Inf if it
+	// is unreachable
+	dist []float64
+
+	// The number of shortest paths from the source to each vertex
+	sigma []float64
+
+	// The predecessors of each vertex on its shortest paths
+	preds [][]int
+}
+
+// singleSourceShortestPaths computes the shortest paths from the
+// given source position, using either BFS or Dijkstra's algorithm.
+func singleSourceShortestPaths[T comparable](arcs [][]indexedArc[T], source int, weighted bool) *shortestPaths {
+	n := len(arcs)
+	sp := &shortestPaths{
+		order: make([]int, 0, n),
+		dist:  make([]float64, n),
+		sigma: make([]float64, n),
+		preds: make([][]int, n),
+	}
+	for i := range sp.dist {
+		sp.dist[i] = math.Inf(1)
+	}
+	sp.dist[source] = 0
+	sp.sigma[source] = 1
+
+	if !weighted {
+		queue := deque.New[int]()
+		queue.PushBack(source)
+		for !queue.IsEmpty() {
+			v, err := queue.PopFront()
+			if err != nil {
+				panic(err)
+			}
+			sp.order = append(sp.order, v)
+			for _, arc := range arcs[v] {
+				u := arc.to
+				if math.IsInf(sp.dist[u], 1) {
+					sp.dist[u] = sp.dist[v] + 1
+					queue.PushBack(u)
+				}
+				if sp.dist[u] == sp.dist[v]+1 {
+					sp.sigma[u] += sp.sigma[v]
+					sp.preds[u] = append(sp.preds[u], v)
+				}
+			}
+		}
+
+		return sp
+	}
+
+	done := make([]bool, n)
+	queued := make([]bool, n)
+	queue := priorityqueue.New[int, float64](priorityqueue.MinHeap)
+	queue.Put(source, 0)
+	queued[source] = true
+	for !queue.IsEmpty() {
+		v := queue.Get().Value
+		queued[v] = false
+		done[v] = true
+		sp.order = append(sp.order, v)
+		for _, arc := range arcs[v] {
+			u := arc.to
+			if done[u] {
+				continue
+			}
+			alt := sp.dist[v] + arc.weight
+			if alt < sp.dist[u] {
+				sp.dist[u] = alt
+				sp.sigma[u] = sp.sigma[v]
+				sp.preds[u] = append(sp.preds[u][:0], v)
+				if queued[u] {
+					queue.Update(u, alt)
+				} else {
+					queue.Put(u, alt)
+					queued[u] = true
+				}
+			} else if alt == sp.dist[u] {
+				sp.sigma[u] += sp.sigma[v]
+				sp.preds[u] = append(sp.preds[u], v)
+			}
+		}
+	}
+
+	return sp
+}
+
+// validateWeights returns an error if any of the edges in the graph
+// has a negative weight.
+func validateWeights[T comparable](g Graph[T]) error {
+	for _, e := range g.GetEdges() {
+		if e.Weight < 0 {
+			return fmt.Errorf("Negative weight for edge %v-%v", e.From, e.To)
+		}
+	}
+
+	return nil
+}
+
+// forEachSource calls fn for each vertex position in the range
+// [0, n), splitting the work across the given number of goroutines.
+// Each goroutine gets its own accumulator created by newAcc, and the
+// accumulators are returned once all goroutines have completed.
+func forEachSource[A any](n, workers int, newAcc func() A, fn func(acc A, source int)) []A {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = max(1, min(workers, n))
+
+	accs := make([]A, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		accs[w] = newAcc()
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for source := w; source < n; source += workers {
+				fn(accs[w], source)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	return accs
+}
+
+// DegreeCentrality returns the degree centrality of the vertices in
+// the graph. For directed graphs the sum of the in- and out-degree
+// is used. When normalized is true, the values are divided by the
+// maximum possible degree, which is n-1 for simple graphs.
+func DegreeCentrality[T comparable](g Graph[T], normalized bool) map[T]float64 {
+	return degreeCentrality(g, normalized, func(d Degree) int {
+		if g.Kind() == KindUndirected {
+			return d.Out
+		}
+		return d.In + d.Out
+	})
+}
+
+// InDegreeCentrality returns the in-degree centrality of the vertices
+// in the graph.
+func InDegreeCentrality[T comparable](g Graph[T], normalized bool) map[T]float64 {
+	return degreeCentrality(g, normalized, func(d Degree) int {
+		return d.In
+	})
+}
+
+// OutDegreeCentrality returns the out-degree centrality of the
+// vertices in the graph.
+func OutDegreeCentrality[T comparable](g Graph[T], normalized bool) map[T]float64 {
+	return degreeCentrality(g, normalized, func(d Degree) int {
+		return d.Out
+	})
+}
+
+// degreeCentrality computes the centrality of each vertex using the
+// given degree function
+func degreeCentrality[T comparable](g Graph[T], normalized bool, degreeFunc func(d Degree) int) map[T]float64 {
+	vertices := g.GetVertices()
+	result := make(map[T]float64, len(vertices))
+	scale := 1.0
+	if normalized && len(vertices) > 1 {
+		scale = 1.0 / float64(len(vertices)-1)
+	}
+
+	for _, v := range vertices {
+		result[v.Value] = float64(degreeFunc(v.Degree)) * scale
+	}
+
+	return result
+}
+
+// BetweennessCentrality computes the betweenness centrality of the
+// vertices in the graph using Brandes' algorithm. Shortest paths are
+// computed using BFS, or Dijkstra's algorithm when opts.Weighted is
+// true.
+//
+// When opts is nil, the options returned by DefaultCentralityOptions
+// are used.
+func BetweennessCentrality[T comparable](g Graph[T], opts *CentralityOptions) (map[T]float64, error) {
+	if opts == nil {
+		opts = DefaultCentralityOptions()
+	}
+	if opts.Weighted {
+		if err := validateWeights(g); err != nil {
+			return nil, err
+		}
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+
+	newAcc := func() []float64 {
+		return make([]float64, n)
+	}
+	accumulate := func(acc []float64, source int) {
+		sp := singleSourceShortestPaths(arcs, source, opts.Weighted)
+		delta := make([]float64, n)
+		for i := len(sp.order) - 1; i >= 0; i-- {
+			w := sp.order[i]
+			for _, v := range sp.preds[w] {
+				delta[v] += sp.sigma[v] / sp.sigma[w] * (1 + delta[w])
+			}
+			if w != source {
+				acc[w] += delta[w]
+			}
+		}
+	}
+
+	betweenness := make([]float64, n)
+	for _, acc := range forEachSource(n, opts.Workers, newAcc, accumulate) {
+		for i, v := range acc {
+			betweenness[i] += v
+		}
+	}
+
+	// Rescale the values
+	scale := 1.0
+	if opts.Normalized {
+		if n > 2 {
+			scale = 1.0 / float64((n-1)*(n-2))
+		}
+	} else if g.Kind() == KindUndirected {
+		// Each pair of vertices has been counted twice
+		scale = 0.5
+	}
+
+	result := make(map[T]float64, n)
+	for i, v := range idx.values {
+		result[v] = betweenness[i] * scale
+	}
+
+	return result, nil
+}
+
+// distanceCentrality computes a centrality measure, which is based on
+// the distances from each vertex to all vertices reachable from it.
+func distanceCentrality[T comparable](g Graph[T], opts *CentralityOptions, measure func(dist []float64, source int) float64) (map[T]float64, error) {
+	if opts == nil {
+		opts = DefaultCentralityOptions()
+	}
+	if opts.Weighted {
+		if err := validateWeights(g); err != nil {
+			return nil, err
+		}
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+	values := make([]float64, n)
+
+	// Each source only updates its own position, so all
+	// goroutines can safely share the same slice.
+	newAcc := func() []float64 {
+		return values
+	}
+	compute := func(acc []float64, source int) {
+		sp := singleSourceShortestPaths(arcs, source, opts.Weighted)
+		acc[source] = measure(sp.dist, source)
+	}
+	forEachSource(n, opts.Workers, newAcc, compute)
+
+	result := make(map[T]float64, n)
+	for i, v := range idx.values {
+		result[v] = values[i]
+	}
+
+	return result, nil
+}
+
+// ClosenessCentrality computes the closeness centrality of the
+// vertices in the graph, which is the reciprocal of the average
+// distance from a vertex to all vertices reachable from it.
+//
+// When opts.Normalized is true, the Wasserman and Faust formula is
+// used, which scales the value by the fraction of reachable vertices.
+// This makes the values comparable across disconnected components.
+func ClosenessCentrality[T comparable](g Graph[T], opts *CentralityOptions) (map[T]float64, error) {
+	normalized := opts == nil || opts.Normalized
+	n := len(g.GetVertexValues())
+
+	measure := func(dist []float64, source int) float64 {
+		total := 0.0
+		reachable := 0
+		for i, d := range dist {
+			if i == source || math.IsInf(d, 1) {
+				continue
+			}
+			total += d
+			reachable++
+		}
+		if total == 0 {
+			return 0.0
+		}
+
+		closeness := float64(reachable) / total
+		if normalized {
+			closeness *= float64(reachable) / float64(n-1)
+		}
+
+		return closeness
+	}
+
+	return distanceCentrality(g, opts, measure)
+}
+
+// HarmonicCentrality computes the harmonic centrality of the vertices
+// in the graph, which is the sum of the reciprocals of the distances
+// from a vertex to all other vertices. Unreachable vertices
+// contribute zero to the sum.
+//
+// When opts.Normalized is true, the values are divided by n-1.
+func HarmonicCentrality[T comparable](g Graph[T], opts *CentralityOptions) (map[T]float64, error) {
+	normalized := opts == nil || opts.Normalized
+	n := len(g.GetVertexValues())
+
+	measure := func(dist []float64, source int) float64 {
+		total := 0.0
+		for i, d := range dist {
+			if i == source || math.IsInf(d, 1) || d == 0 {
+				continue
+			}
+			total += 1.0 / d
+		}
+
+		if normalized && n > 1 {
+			total /= float64(n - 1)
+		}
+
+		return total
+	}
+
+	return distanceCentrality(g, opts, measure)
+}
+
+// incomingArcs returns the incoming arcs of each vertex, where the
+// destination of each arc is the vertex it originates from.
+func incomingArcs[T comparable](arcs [][]indexedArc[T]) [][]indexedArc[T] {
+	incoming := make([][]indexedArc[T], len(arcs))
+	for v, items := range arcs {
+		for _, arc := range items {
+			incoming[arc.to] = append(incoming[arc.to], indexedArc[T]{to: v, weight: arc.weight, edge: arc.edge})
+		}
+	}
+
+	return incoming
+}
+
+// powerIteration repeatedly applies the given step function to a
+// vector, until the change between two consecutive iterations falls
+// below the tolerance. The step function receives the current
+// vector, and stores the next one into its second argument.
+func powerIteration(n int, opts *CentralityOptions, start float64, step func(x, next []float64)) ([]float64, error) {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = start
+	}
+	next := make([]float64, n)
+
+	for iter := 0; iter < opts.MaxIterations; iter++ {
+		step(x, next)
+
+		delta := 0.0
+		for i := range x {
+			delta += math.Abs(next[i] - x[i])
+		}
+		x, next = next, x
+
+		if delta < float64(n)*opts.Tolerance {
+			return x, nil
+		}
+	}
+
+	return nil, ErrNotConverged
+}
+
+// euclideanNormalize scales the vector to unit Euclidean length
+func euclideanNormalize(x []float64) {
+	norm := 0.0
+	for _, v := range x {
+		norm += v * v
+	}
+	norm = math.Sqrt(norm)
+	if norm == 0 {
+		return
+	}
+
+	for i := range x {
+		x[i] /= norm
+	}
+}
+
+// EigenvectorCentrality computes the eigenvector centrality of the
+// vertices in the graph using power iterations. For directed graphs
+// the centrality of a vertex depends on the centrality of its
+// predecessors.
+//
+// The result is always normalized to unit Euclidean length. Returns
+// ErrNotConverged, if the iterations do not converge.
+func EigenvectorCentrality[T comparable](g Graph[T], opts *CentralityOptions) (map[T]float64, error) {
+	if opts == nil {
+		opts = DefaultCentralityOptions()
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	result := make(map[T]float64, n)
+	if n == 0 {
+		return result, nil
+	}
+	incoming := incomingArcs(idx.arcs(g))
+
+	// Using the shifted matrix (A + I) guarantees convergence for
+	// bipartite graphs, while preserving the eigenvectors.
+	step := func(x, next []float64) {
+		copy(next, x)
+		for v, items := range incoming {
+			for _, arc := range items {
+				w := 1.0
+				if opts.Weighted {
+					w = arc.weight
+				}
+				next[v] += x[arc.to] * w
+			}
+		}
+		euclideanNormalize(next)
+	}
+
+	x, err := powerIteration(n, opts, 1.0/float64(n), step)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range idx.values {
+		result[v] = x[i]
+	}
+
+	return result, nil
+}
+
+// KatzCentrality computes the Katz centrality of the vertices in the
+// graph using power iterations, based on the opts.Alpha attenuation
+// factor and opts.Beta weight. For directed graphs the centrality of
+// a vertex depends on the centrality of its predecessors.
+//
+// Alpha must be smaller than the reciprocal of the largest eigenvalue
+// of the adjacency matrix, otherwise ErrNotConverged is returned.
+// When opts.Normalized is true, the result is normalized to unit
+// Euclidean length.
+func KatzCentrality[T comparable](g Graph[T], opts *CentralityOptions) (map[T]float64, error) {
+	if opts == nil {
+		opts = DefaultCentralityOptions()
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	result := make(map[T]float64, n)
+	if n == 0 {
+		return result, nil
+	}
+	incoming := incomingArcs(idx.arcs(g))
+
+	step := func(x, next []float64) {
+		for v, items := range incoming {
+			total := 0.0
+			for _, arc := range items {
+				w := 1.0
+				if opts.Weighted {
+					w = arc.weight
+				}
+				total += x[arc.to] * w
+			}
+			next[v] = opts.Alpha*total + opts.Beta
+		}
+	}
+
+	x, err := powerIteration(n, opts, 0.0, step)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Normalized {
+		euclideanNormalize(x)
+	}
+
+	for i, v := range idx.values {
+		result[v] = x[i]
+	}
+
+	return result, nil
+}
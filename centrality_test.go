@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Creates a new undirected path graph 1 - 2 - 3 - 4 - 5
+func newPathGraph() graph.Graph[int] {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+
+	return g
+}
+
+func TestDegreeCentrality(t *testing.T) {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+
+	got := graph.DegreeCentrality(g, true)
+	verifyFloatMap(t, map[int]float64{0: 1.0, 1: 1.0 / 3, 2: 1.0 / 3, 3: 1.0 / 3}, got, 1.0e-9)
+
+	got = graph.DegreeCentrality(g, false)
+	verifyFloatMap(t, map[int]float64{0: 3, 1: 1, 2: 1, 3: 1}, got, 1.0e-9)
+
+	d := newDirectedGraph()
+	verifyFloatMap(t, graph.InDegreeCentrality(d, false), map[int]float64{1: 0, 2: 1, 3: 1, 4: 1, 5: 1, 10: 0, 11: 1, 12: 1, 13: 1}, 1.0e-9)
+	verifyFloatMap(t, graph.OutDegreeCentrality(d, false), map[int]float64{1: 2, 2: 0, 3: 1, 4: 1, 5: 0, 10: 1, 11: 2, 12: 0, 13: 0}, 1.0e-9)
+	verifyFloatMap(t, graph.DegreeCentrality(d, false), map[int]float64{1: 2, 2: 1, 3: 2, 4: 2, 5: 1, 10: 1, 11: 3, 12: 1, 13: 1}, 1.0e-9)
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	g := newPathGraph()
+	got, err := graph.BetweennessCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0, 2: 0.5, 3: 4.0 / 6, 4: 0.5, 5: 0}, got, 1.0e-9)
+
+	opts := graph.DefaultCentralityOptions()
+	opts.Normalized = false
+	got, err = graph.BetweennessCentrality(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0, 2: 3, 3: 4, 4: 3, 5: 0}, got, 1.0e-9)
+
+	// Directed path
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	got, err = graph.BetweennessCentrality(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0, 2: 0.5, 3: 0}, got, 1.0e-9)
+
+	// Weighted cycle, where the shortest paths differ from the
+	// ones with the least number of hops
+	w := graph.New[int](graph.KindUndirected)
+	w.AddWeightedEdge(1, 2, 1)
+	w.AddWeightedEdge(2, 3, 1)
+	w.AddWeightedEdge(1, 4, 5)
+	w.AddWeightedEdge(4, 3, 5)
+
+	got, err = graph.BetweennessCentrality(w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0.5, 2: 0.5, 3: 0.5, 4: 0.5}, got, 1.0e-9)
+
+	opts.Weighted = true
+	got, err = graph.BetweennessCentrality(w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0.5, 2: 1, 3: 0.5, 4: 0}, got, 1.0e-9)
+
+	// Negative weights are not supported
+	w.AddWeightedEdge(1, 3, -1)
+	if _, err := graph.BetweennessCentrality(w, opts); err == nil {
+		t.Fatal("BetweennessCentrality: should fail with negative weights")
+	}
+}
+
+func TestBetweennessCentralityParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	g := newRandomUndirectedGraph(r, 60, 0.1)
+
+	opts := graph.DefaultCentralityOptions()
+	opts.Weighted = true
+	opts.Workers = 1
+	sequential, err := graph.BetweennessCentrality(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Workers = 8
+	parallel, err := graph.BetweennessCentrality(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifyFloatMap(t, sequential, parallel, 1.0e-9)
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	g := newPathGraph()
+	got, err := graph.ClosenessCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 0.4, 2: 4.0 / 7, 3: 4.0 / 6, 4: 4.0 / 7, 5: 0.4}, got, 1.0e-9)
+
+	// Disconnected vertices are scaled by the fraction of
+	// reachable vertices
+	g.AddEdge(6, 7)
+	got, err = graph.ClosenessCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got[6]-1.0/6) > 1.0e-9 {
+		t.Fatalf("want closeness %.6f, got %.6f", 1.0/6, got[6])
+	}
+
+	opts := graph.DefaultCentralityOptions()
+	opts.Normalized = false
+	got, err = graph.ClosenessCentrality(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[6] != 1.0 {
+		t.Fatalf("want closeness 1.0, got %.6f", got[6])
+	}
+}
+
+func TestHarmonicCentrality(t *testing.T) {
+	g := newPathGraph()
+	got, err := graph.HarmonicCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := (1.0 + 1.0/2 + 1.0/3 + 1.0/4) / 4
+	middle := (1.0 + 1.0 + 1.0/2 + 1.0/2) / 4
+	verifyFloatMap(t, map[int]float64{1: end, 3: middle, 5: end, 2: (1.0 + 1.0 + 1.0/2 + 1.0/3) / 4, 4: (1.0 + 1.0 + 1.0/2 + 1.0/3) / 4}, got, 1.0e-9)
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+
+	got, err := graph.EigenvectorCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := 1.0 / math.Sqrt(6)
+	verifyFloatMap(t, map[int]float64{0: math.Sqrt(3) * leaf, 1: leaf, 2: leaf, 3: leaf}, got, 1.0e-4)
+
+	opts := graph.DefaultCentralityOptions()
+	opts.MaxIterations = 1
+	if _, err := graph.EigenvectorCentrality(g, opts); err != graph.ErrNotConverged {
+		t.Fatal("EigenvectorCentrality: should fail to converge")
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	opts := graph.DefaultCentralityOptions()
+	opts.Normalized = false
+	got, err := graph.KatzCentrality(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 1.0, 2: 1.1, 3: 1.11}, got, 1.0e-9)
+
+	got, err = graph.KatzCentrality(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	norm := math.Sqrt(1.0 + 1.1*1.1 + 1.11*1.11)
+	verifyFloatMap(t, map[int]float64{1: 1.0 / norm, 2: 1.1 / norm, 3: 1.11 / norm}, got, 1.0e-9)
+
+	// Alpha is too large for the graph
+	cycle := graph.New[int](graph.KindDirected)
+	cycle.AddEdge(1, 2)
+	cycle.AddEdge(2, 1)
+	opts.Alpha = 2.0
+	if _, err := graph.KatzCentrality(cycle, opts); err != graph.ErrNotConverged {
+		t.Fatal("KatzCentrality: should fail to converge")
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+// neighbourSets contains the neighbour sets of each vertex in terms
+// of vertex positions. Edges are treated as undirected, and
+// self-loops are excluded.
+type neighbourSets []map[int]bool
+
+// newNeighbourSets creates the neighbour sets of the given graph
+func newNeighbourSets[T comparable](g Graph[T], idx *vertexIndex[T]) neighbourSets {
+	ns := make(neighbourSets, len(idx.values))
+	for i := range ns {
+		ns[i] = make(map[int]bool)
+	}
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from != to {
+			ns[from][to] = true
+			ns[to][from] = true
+		}
+	}
+
+	return ns
+}
+
+// degeneracyOrder returns the vertices ordered by repeatedly removing
+// a vertex of minimum degree from the graph.
+func (ns neighbourSets) degeneracyOrder() []int {
+	incident := make([][]int, len(ns))
+	degree := make([]int, len(ns))
+	for v, items := range ns {
+		for u := range items {
+			incident[v] = append(incident[v], u)
+		}
+		degree[v] = len(items)
+	}
+	order, _ := coreDecomposition(incident, degree)
+
+	return order
+}
+
+// bronKerbosch reports all maximal cliques, which extend the clique R
+// with vertices from P, and exclude vertices from X.
+func (ns neighbourSets) bronKerbosch(r []int, p, x map[int]bool, report func(clique []int) error) error {
+	if len(p) == 0 && len(x) == 0 {
+		return report(r)
+	}
+
+	// Choose a pivot, which maximizes the number of its neighbours
+	// in P, so that fewer branches need to be explored
+	pivot := -1
+	best := -1
+	for _, set := range []map[int]bool{p, x} {
+		for u := range set {
+			count := 0
+			for v := range p {
+				if ns[u][v] {
+					count++
+				}
+			}
+			if count > best {
+				best = count
+				pivot = u
+			}
+		}
+	}
+
+	candidates := make([]int, 0, len(p))
+	for v := range p {
+		if !ns[pivot][v] {
+			candidates = append(candidates, v)
+		}
+	}
+
+	for _, v := range candidates {
+		newP := make(map[int]bool)
+		newX := make(map[int]bool)
+		for u := range ns[v] {
+			if p[u] {
+				newP[u] = true
+			}
+			if x[u] {
+				newX[u] = true
+			}
+		}
+
+		if err := ns.bronKerbosch(append(r, v), newP, newX, report); err != nil {
+			return err
+		}
+
+		delete(p, v)
+		x[v] = true
+	}
+
+	return nil
+}
+
+// MaximalCliques walks over the maximal cliques of an undirected
+// graph, using the Bron-Kerbosch algorithm with pivoting. The outer
+// level of the recursion processes the vertices in degeneracy order,
+// which bounds the running time for sparse graphs.
+//
+// Self-loops are ignored, and isolated vertices are reported as
+// cliques of a single vertex. In order to stop walking the graph,
+// walkFunc should return ErrStopWalking.
+func MaximalCliques[T comparable](g Graph[T], walkFunc func(clique []T) error) error {
+	if g.Kind() != KindUndirected {
+		return ErrIsNotUndirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	ns := newNeighbourSets(g, idx)
+
+	report := func(clique []int) error {
+		values := make([]T, 0, len(clique))
+		for _, v := range clique {
+			values = append(values, idx.values[v])
+		}
+		return walkFunc(values)
+	}
+
+	order := ns.degeneracyOrder()
+	position := make([]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	for _, v := range order {
+		p := make(map[int]bool)
+		x := make(map[int]bool)
+		for u := range ns[v] {
+			if position[u] > position[v] {
+				p[u] = true
+			} else {
+				x[u] = true
+			}
+		}
+
+		err := ns.bronKerbosch([]int{v}, p, x, report)
+		if err == ErrStopWalking {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MaximumClique returns a clique of maximum size in an undirected
+// graph.
+func MaximumClique[T comparable](g Graph[T]) ([]T, error) {
+	result := make([]T, 0)
+	walker := func(clique []T) error {
+		if len(clique) > len(result) {
+			result = clique
+		}
+		return nil
+	}
+
+	if err := MaximalCliques(g, walker); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CliqueNumber returns the size of the largest clique in an
+// undirected graph.
+func CliqueNumber[T comparable](g Graph[T]) (int, error) {
+	clique, err := MaximumClique(g)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(clique), nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Computes the maximal cliques of a graph with vertices 0 .. n-1 by
+// exhaustive search. Each clique is represented by its sorted
+// vertices, formatted as a string.
+func bruteForceMaximalCliques(g graph.Graph[int], n int) map[string]bool {
+	isClique := func(mask int) bool {
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if mask&(1<<u) != 0 && mask&(1<<v) != 0 && !g.EdgeExists(u, v) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	result := make(map[string]bool)
+	for mask := 1; mask < 1<<n; mask++ {
+		if !isClique(mask) {
+			continue
+		}
+
+		maximal := true
+		for v := 0; v < n; v++ {
+			if mask&(1<<v) == 0 && isClique(mask|(1<<v)) {
+				maximal = false
+				break
+			}
+		}
+		if !maximal {
+			continue
+		}
+
+		clique := make([]int, 0)
+		for v := 0; v < n; v++ {
+			if mask&(1<<v) != 0 {
+				clique = append(clique, v)
+			}
+		}
+		result[fmt.Sprint(clique)] = true
+	}
+
+	return result
+}
+
+func TestMaximalCliques(t *testing.T) {
+	dummyWalker := func(clique []int) error {
+		return nil
+	}
+	if err := graph.MaximalCliques(newDirectedGraph(), dummyWalker); err != graph.ErrIsNotUndirectedGraph {
+		t.Fatal("MaximalCliques: should fail on directed graphs")
+	}
+
+	// Cross-check against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(10) + 1
+		g := newRandomUndirectedGraph(r, n, r.Float64())
+		want := bruteForceMaximalCliques(g, n)
+
+		got := make(map[string]bool)
+		walker := func(clique []int) error {
+			clique = slices.Clone(clique)
+			slices.Sort(clique)
+			key := fmt.Sprint(clique)
+			if got[key] {
+				t.Fatalf("clique %s reported more than once", key)
+			}
+			got[key] = true
+			return nil
+		}
+		if err := graph.MaximalCliques(g, walker); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("want %d maximal cliques, got %d", len(want), len(got))
+		}
+		for k := range want {
+			if !got[k] {
+				t.Fatalf("maximal clique %s not found", k)
+			}
+		}
+	}
+
+	// Stop walking after the first clique
+	count := 0
+	walker := func(clique []int) error {
+		count++
+		return graph.ErrStopWalking
+	}
+	if err := graph.MaximalCliques(newRingOfCliquesGraph(4, 4), walker); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("want 1 clique, got %d", count)
+	}
+}
+
+func TestMaximumClique(t *testing.T) {
+	g := newRingOfCliquesGraph(3, 4)
+	g.AddEdge(100, 101)
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			g.AddEdge(200+i, 200+j)
+		}
+	}
+
+	clique, err := graph.MaximumClique(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(clique)
+	if !slices.Equal(clique, []int{200, 201, 202, 203, 204}) {
+		t.Fatalf("want maximum clique [200 201 202 203 204], got %v", clique)
+	}
+
+	number, err := graph.CliqueNumber(newPathGraph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number != 2 {
+		t.Fatalf("want clique number 2, got %d", number)
+	}
+
+	number, err = graph.CliqueNumber(graph.New[int](graph.KindUndirected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number != 0 {
+		t.Fatalf("want clique number 0, got %d", number)
+	}
+}
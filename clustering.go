@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"slices"
+)
+
+// TriadTypes contains the names of the sixteen possible triad types
+// in a directed graph, using the M-A-N naming convention of Holland
+// and Leinhardt.
+var TriadTypes = []string{
+	"003", "012", "102", "021D", "021U", "021C", "111D", "111U",
+	"030T", "030C", "201", "120D", "120U", "120C", "210", "300",
+}
+
+// triadCodes maps each of the 64 possible configurations of edges
+// between three vertices to its triad type, as an index in
+// TriadTypes.
+var triadCodes = []int{
+	0, 1, 1, 2, 1, 3, 5, 7, 1, 5, 4, 6, 2, 7, 6, 10,
+	1, 5, 3, 7, 4, 8, 8, 12, 5, 9, 8, 13, 6, 13, 11, 14,
+	1, 4, 5, 6, 5, 8, 9, 13, 3, 8, 8, 11, 7, 12, 13, 14,
+	2, 6, 7, 10, 6, 11, 13, 14, 7, 13, 12, 14, 10, 14, 14, 15,
+}
+
+// degreeOrdering returns the distinct neighbours of each vertex of an
+// undirected graph, which come after the vertex when ordering the
+// vertices by degree. Self-loops are ignored.
+func degreeOrdering[T comparable](g Graph[T]) ([]T, map[T][]T) {
+	vertices := g.GetVertexValues()
+	rank := make(map[T]int, len(vertices))
+	degree := make(map[T]int, len(vertices))
+	for _, v := range vertices {
+		degree[v] = g.GetVertex(v).Degree.Out
+	}
+	slices.SortStableFunc(vertices, func(a, b T) int {
+		return degree[a] - degree[b]
+	})
+	for i, v := range vertices {
+		rank[v] = i
+	}
+
+	forward := make(map[T][]T, len(vertices))
+	for _, v := range vertices {
+		seen := make(map[T]bool)
+		for _, u := range g.GetNeighbours(v) {
+			if rank[u] > rank[v] && !seen[u] {
+				seen[u] = true
+				forward[v] = append(forward[v], u)
+			}
+		}
+	}
+
+	return vertices, forward
+}
+
+// WalkTriangles walks over the triangles of an undirected graph. Each
+// triangle is yielded exactly once, as a slice of its three vertices.
+//
+// Triangles are enumerated by intersecting the adjacency of vertices
+// in order of increasing degree, which takes O(E^1.5) time.
+func WalkTriangles[T comparable](g Graph[T], walkFunc func(triangle []T) error) error {
+	if g.Kind() != KindUndirected {
+		return ErrIsNotUndirectedGraph
+	}
+
+	vertices, forward := degreeOrdering(g)
+	for _, v := range vertices {
+		for _, u := range forward[v] {
+			for _, w := range forward[u] {
+				if !g.IsNeighbour(v, w) {
+					continue
+				}
+
+				err := walkFunc([]T{v, u, w})
+				if err == ErrStopWalking {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Triangles returns the number of triangles, which each vertex of an
+// undirected graph is part of.
+func Triangles[T comparable](g Graph[T]) (map[T]int, error) {
+	result := make(map[T]int)
+	for _, v := range g.GetVertexValues() {
+		result[v] = 0
+	}
+
+	walker := func(triangle []T) error {
+		for _, v := range triangle {
+			result[v]++
+		}
+		return nil
+	}
+
+	if err := WalkTriangles(g, walker); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// distinctDegree returns the number of distinct neighbours of V,
+// excluding V itself
+func distinctDegree[T comparable](g Graph[T], v T) int {
+	degree := 0
+	seen := make(map[T]bool)
+	for _, u := range g.GetNeighbours(v) {
+		if u != v && !seen[u] {
+			seen[u] = true
+			degree++
+		}
+	}
+
+	return degree
+}
+
+// LocalClustering returns the local clustering coefficient of each
+// vertex of an undirected graph, which is the fraction of pairs of
+// neighbours of the vertex, which are connected by an edge.
+func LocalClustering[T comparable](g Graph[T]) (map[T]float64, error) {
+	triangles, err := Triangles(g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[T]float64, len(triangles))
+	for v, t := range triangles {
+		d := distinctDegree(g, v)
+		if d < 2 {
+			result[v] = 0.0
+			continue
+		}
+		result[v] = 2.0 * float64(t) / float64(d*(d-1))
+	}
+
+	return result, nil
+}
+
+// AverageClustering returns the average of the local clustering
+// coefficients of the vertices of an undirected graph.
+func AverageClustering[T comparable](g Graph[T]) (float64, error) {
+	clustering, err := LocalClustering(g)
+	if err != nil {
+		return 0, err
+	}
+	if len(clustering) == 0 {
+		return 0, nil
+	}
+
+	total := 0.0
+	for _, c := range clustering {
+		total += c
+	}
+
+	return total / float64(len(clustering)), nil
+}
+
+// Transitivity returns the transitivity of an undirected graph,
+// which is the fraction of connected triples of vertices, which form
+// triangles.
+func Transitivity[T comparable](g Graph[T]) (float64, error) {
+	triangles, err := Triangles(g)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	triples := 0
+	for v, t := range triangles {
+		d := distinctDegree(g, v)
+		closed += t
+		triples += d * (d - 1) / 2
+	}
+	if triples == 0 {
+		return 0, nil
+	}
+
+	return float64(closed) / float64(triples), nil
+}
+
+// TriadCensus returns the number of triads of each type in a directed
+// graph, keyed by the names from TriadTypes.
+//
+// The census is computed using the algorithm of Batagelj and Mrvar,
+// which only considers connected triads explicitly.
+func TriadCensus[T comparable](g Graph[T]) (map[string]int, error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+
+	// The undirected neighbours of each vertex, excluding itself
+	neighbours := make([]map[int]bool, n)
+	for i := range neighbours {
+		neighbours[i] = make(map[int]bool)
+	}
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from != to {
+			neighbours[from][to] = true
+			neighbours[to][from] = true
+		}
+	}
+
+	isEdge := func(from, to int) bool {
+		return g.IsNeighbour(idx.values[from], idx.values[to])
+	}
+	code := func(v, u, w int) int {
+		c := 0
+		for _, item := range []struct{ from, to, bit int }{
+			{v, u, 1}, {u, v, 2}, {v, w, 4}, {w, v, 8}, {u, w, 16}, {w, u, 32},
+		} {
+			if isEdge(item.from, item.to) {
+				c += item.bit
+			}
+		}
+		return c
+	}
+
+	census := make([]int, len(TriadTypes))
+	for v := 0; v < n; v++ {
+		for u := range neighbours[v] {
+			if u <= v {
+				continue
+			}
+
+			// The union of the neighbourhoods of V and U
+			union := make(map[int]bool)
+			for w := range neighbours[v] {
+				union[w] = true
+			}
+			for w := range neighbours[u] {
+				union[w] = true
+			}
+			delete(union, u)
+			delete(union, v)
+
+			// Count the connected triads, making sure that
+			// each one is counted only once
+			for w := range union {
+				if u < w || (v < w && w < u && !neighbours[v][w]) {
+					census[triadCodes[code(v, u, w)]]++
+				}
+			}
+
+			// The remaining triads contain only the dyad
+			// between V and U
+			if isEdge(v, u) && isEdge(u, v) {
+				census[2] += n - len(union) - 2
+			} else {
+				census[1] += n - len(union) - 2
+			}
+		}
+	}
+
+	result := make(map[string]int, len(TriadTypes))
+	total := 0
+	for i, name := range TriadTypes {
+		result[name] = census[i]
+		total += census[i]
+	}
+	result["003"] = n*(n-1)*(n-2)/6 - total
+
+	return result, nil
+}
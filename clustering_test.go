@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Creates a new undirected graph of a triangle with a pendant vertex
+func newPawGraph() graph.Graph[int] {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+
+	return g
+}
+
+func TestTriangles(t *testing.T) {
+	if _, err := graph.Triangles(newDirectedGraph()); err != graph.ErrIsNotUndirectedGraph {
+		t.Fatal("Triangles: should fail on directed graphs")
+	}
+
+	got, err := graph.Triangles(newPawGraph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]int{1: 1, 2: 1, 3: 1, 4: 0}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("want %d triangles for vertex %d, got %d", v, k, got[k])
+		}
+	}
+
+	// Cross-check against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		g := newRandomUndirectedGraph(r, 12, r.Float64())
+		want := 0
+		for a := 0; a < 12; a++ {
+			for b := a + 1; b < 12; b++ {
+				for c := b + 1; c < 12; c++ {
+					if g.EdgeExists(a, b) && g.EdgeExists(b, c) && g.EdgeExists(a, c) {
+						want++
+					}
+				}
+			}
+		}
+
+		got := 0
+		walker := func(triangle []int) error {
+			if !g.EdgeExists(triangle[0], triangle[1]) || !g.EdgeExists(triangle[1], triangle[2]) || !g.EdgeExists(triangle[0], triangle[2]) {
+				t.Fatalf("%v is not a triangle", triangle)
+			}
+			got++
+			return nil
+		}
+		if err := graph.WalkTriangles(g, walker); err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("want %d triangles, got %d", want, got)
+		}
+	}
+
+	// Stop walking after the first triangle
+	count := 0
+	walker := func(triangle []int) error {
+		count++
+		return graph.ErrStopWalking
+	}
+	g := newRingOfCliquesGraph(3, 4)
+	if err := graph.WalkTriangles(g, walker); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("want 1 triangle, got %d", count)
+	}
+}
+
+func TestClustering(t *testing.T) {
+	g := newPawGraph()
+	got, err := graph.LocalClustering(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 1, 2: 1, 3: 1.0 / 3, 4: 0}, got, 1.0e-9)
+
+	avg, err := graph.AverageClustering(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (2 + 1.0/3) / 4; math.Abs(avg-want) > 1.0e-9 {
+		t.Fatalf("want average clustering %.6f, got %.6f", want, avg)
+	}
+
+	transitivity, err := graph.Transitivity(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(transitivity-0.6) > 1.0e-9 {
+		t.Fatalf("want transitivity 0.6, got %.6f", transitivity)
+	}
+
+	// Graphs without triangles
+	transitivity, err = graph.Transitivity(newPathGraph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transitivity != 0 {
+		t.Fatalf("want transitivity 0, got %.6f", transitivity)
+	}
+}
+
+func TestTriadCensus(t *testing.T) {
+	if _, err := graph.TriadCensus(newUndirectedGraph()); err != graph.ErrIsNotDirectedGraph {
+		t.Fatal("TriadCensus: should fail on undirected graphs")
+	}
+
+	// Triads of three vertices with a single well-known type
+	type testCase struct {
+		name  string
+		edges [][2]int
+	}
+	testCases := []testCase{
+		{"003", [][2]int{}},
+		{"012", [][2]int{{1, 2}}},
+		{"102", [][2]int{{1, 2}, {2, 1}}},
+		{"021D", [][2]int{{1, 2}, {1, 3}}},
+		{"021U", [][2]int{{2, 1}, {3, 1}}},
+		{"021C", [][2]int{{1, 2}, {2, 3}}},
+		{"030T", [][2]int{{1, 2}, {2, 3}, {1, 3}}},
+		{"030C", [][2]int{{1, 2}, {2, 3}, {3, 1}}},
+		{"201", [][2]int{{1, 2}, {2, 1}, {1, 3}, {3, 1}}},
+		{"300", [][2]int{{1, 2}, {2, 1}, {1, 3}, {3, 1}, {2, 3}, {3, 2}}},
+	}
+
+	for _, tc := range testCases {
+		g := graph.New[int](graph.KindDirected)
+		g.AddVertex(1)
+		g.AddVertex(2)
+		g.AddVertex(3)
+		for _, e := range tc.edges {
+			g.AddEdge(e[0], e[1])
+		}
+
+		census, err := graph.TriadCensus(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range graph.TriadTypes {
+			want := 0
+			if name == tc.name {
+				want = 1
+			}
+			if census[name] != want {
+				t.Fatalf("%s: want %d triads of type %s, got %d", tc.name, want, name, census[name])
+			}
+		}
+	}
+
+	// Cross-check the number of mutual, asymmetric and null dyads
+	// of each triad type against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	n := 10
+	for i := 0; i < 20; i++ {
+		g := graph.New[int](graph.KindDirected)
+		for v := 0; v < n; v++ {
+			g.AddVertex(v)
+		}
+		p := r.Float64()
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if u != v && r.Float64() < p {
+					g.AddEdge(u, v)
+				}
+			}
+		}
+
+		dyad := func(u, v int) int {
+			if g.EdgeExists(u, v) && g.EdgeExists(v, u) {
+				return 0
+			}
+			if g.EdgeExists(u, v) || g.EdgeExists(v, u) {
+				return 1
+			}
+			return 2
+		}
+		want := make(map[string]int)
+		for a := 0; a < n; a++ {
+			for b := a + 1; b < n; b++ {
+				for c := b + 1; c < n; c++ {
+					man := []int{0, 0, 0}
+					man[dyad(a, b)]++
+					man[dyad(b, c)]++
+					man[dyad(a, c)]++
+					want[string(rune('0'+man[0]))+string(rune('0'+man[1]))+string(rune('0'+man[2]))]++
+				}
+			}
+		}
+
+		census, err := graph.TriadCensus(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]int)
+		for name, count := range census {
+			got[name[:3]] += count
+		}
+		for k, v := range want {
+			if got[k] != v {
+				t.Fatalf("want %d triads with dyads %s, got %d", v, k, got[k])
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ColoringStrategy represents the strategy used when coloring the
+// vertices of a graph
+type ColoringStrategy int
+
+const (
+	// StrategyLargestFirst greedily colors the vertices in order of
+	// decreasing degree
+	StrategyLargestFirst ColoringStrategy = iota
+
+	// StrategySmallestLast greedily colors the vertices in the
+	// reverse order of repeatedly removing a vertex of minimum
+	// degree, which uses at most d+1 colors for a d-degenerate
+	// graph
+	StrategySmallestLast
+
+	// StrategyDSatur colors next the vertex with the largest number
+	// of distinctly colored neighbours, breaking ties by degree
+	StrategyDSatur
+
+	// StrategyWelshPowell assigns one color at a time to as many
+	// vertices as possible, considering them in order of
+	// decreasing degree
+	StrategyWelshPowell
+)
+
+// VertexColoring maps each vertex to its color. Colors are numbered
+// consecutively from zero, and unlike the Color type, which
+// represents the state of a vertex while traversing a graph, are
+// only meaningful in relation to each other.
+type VertexColoring[T comparable] map[T]int
+
+// NumColors returns the number of distinct colors used by the
+// coloring
+func (c VertexColoring[T]) NumColors() int {
+	colors := make(map[int]bool)
+	for _, color := range c {
+		colors[color] = true
+	}
+
+	return len(colors)
+}
+
+// DotColorPalette represents the list of colors, which are used when
+// painting a vertex coloring for the Dot representation of a graph.
+var DotColorPalette = []string{
+	"lightblue", "lightcoral", "palegreen", "gold", "plum",
+	"sandybrown", "lightpink", "aquamarine", "khaki", "lightsalmon",
+	"thistle", "lightseagreen", "wheat", "skyblue", "yellowgreen",
+	"orchid",
+}
+
+// ColorVertices colors the vertices of the graph, such that no two
+// adjacent vertices share the same color, using the given greedy
+// strategy. Edges of directed graphs are treated as undirected, and
+// self-loops are ignored.
+func ColorVertices[T comparable](g Graph[T], strategy ColoringStrategy) (VertexColoring[T], error) {
+	idx := newVertexIndex(g)
+	ns := newNeighbourSets(g, idx)
+	n := len(ns)
+
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+
+	// Returns the smallest color, which is not used by any of the
+	// neighbours of V
+	smallestAvailable := func(v int) int {
+		used := make(map[int]bool)
+		for u := range ns[v] {
+			if colors[u] != -1 {
+				used[colors[u]] = true
+			}
+		}
+		c := 0
+		for used[c] {
+			c++
+		}
+		return c
+	}
+
+	// Vertices ordered by decreasing degree
+	byDegree := func() []int {
+		order := make([]int, n)
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortStableFunc(order, func(a, b int) int {
+			return len(ns[b]) - len(ns[a])
+		})
+		return order
+	}
+
+	switch strategy {
+	case StrategyLargestFirst:
+		for _, v := range byDegree() {
+			colors[v] = smallestAvailable(v)
+		}
+	case StrategySmallestLast:
+		order := ns.degeneracyOrder()
+		slices.Reverse(order)
+		for _, v := range order {
+			colors[v] = smallestAvailable(v)
+		}
+	case StrategyDSatur:
+		saturation := make([]map[int]bool, n)
+		for i := range saturation {
+			saturation[i] = make(map[int]bool)
+		}
+		for colored := 0; colored < n; colored++ {
+			best := -1
+			for v := 0; v < n; v++ {
+				if colors[v] != -1 {
+					continue
+				}
+				if best == -1 ||
+					len(saturation[v]) > len(saturation[best]) ||
+					(len(saturation[v]) == len(saturation[best]) && len(ns[v]) > len(ns[best])) {
+					best = v
+				}
+			}
+
+			colors[best] = smallestAvailable(best)
+			for u := range ns[best] {
+				saturation[u][colors[best]] = true
+			}
+		}
+	case StrategyWelshPowell:
+		order := byDegree()
+		for c, colored := 0, 0; colored < n; c++ {
+			for _, v := range order {
+				if colors[v] != -1 {
+					continue
+				}
+				conflict := false
+				for u := range ns[v] {
+					if colors[u] == c {
+						conflict = true
+						break
+					}
+				}
+				if !conflict {
+					colors[v] = c
+					colored++
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("Unknown coloring strategy %d", strategy)
+	}
+
+	result := make(VertexColoring[T], n)
+	for i, v := range idx.values {
+		result[v] = colors[i]
+	}
+
+	return result, nil
+}
+
+// IsValidColoring returns a boolean indicating whether the coloring
+// assigns a color to each vertex of the graph, such that no two
+// adjacent vertices share the same color. Self-loops are ignored.
+func IsValidColoring[T comparable](g Graph[T], coloring VertexColoring[T]) bool {
+	for _, v := range g.GetVertexValues() {
+		if _, ok := coloring[v]; !ok {
+			return false
+		}
+	}
+
+	for _, e := range g.GetEdges() {
+		if e.From != e.To && coloring[e.From] == coloring[e.To] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PaintVertexColoring sets the fill color of each vertex in the graph
+// according to the given coloring, using the colors from
+// DotColorPalette. The vertices are also styled as filled, so that
+// the colors are drawn. The colors are reused, if the coloring uses
+// more colors than the palette provides.
+//
+// Returns an error, if the coloring contains a negative color, in
+// which case no vertex is painted.
+func PaintVertexColoring[T comparable](g Graph[T], coloring VertexColoring[T]) error {
+	for v, c := range coloring {
+		if c < 0 {
+			return fmt.Errorf("Invalid color %d for vertex %v", c, v)
+		}
+	}
+
+	for _, v := range g.GetVertices() {
+		c, ok := coloring[v.Value]
+		if !ok {
+			continue
+		}
+		v.DotAttributes["style"] = "filled"
+		v.DotAttributes["fillcolor"] = DotColorPalette[c%len(DotColorPalette)]
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+var coloringStrategies = []graph.ColoringStrategy{
+	graph.StrategyLargestFirst,
+	graph.StrategySmallestLast,
+	graph.StrategyDSatur,
+	graph.StrategyWelshPowell,
+}
+
+func TestColorVertices(t *testing.T) {
+	// Complete graph requires a distinct color for each vertex
+	complete := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			complete.AddEdge(i, j)
+		}
+	}
+
+	// Even cycle is bipartite
+	cycle := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 6; i++ {
+		cycle.AddEdge(i, (i+1)%6)
+	}
+
+	for _, strategy := range coloringStrategies {
+		coloring, err := graph.ColorVertices(complete, strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !graph.IsValidColoring(complete, coloring) {
+			t.Fatalf("strategy %d: invalid coloring of complete graph", strategy)
+		}
+		if coloring.NumColors() != 5 {
+			t.Fatalf("strategy %d: want 5 colors, got %d", strategy, coloring.NumColors())
+		}
+
+		// Trees are 1-degenerate
+		if strategy == graph.StrategySmallestLast || strategy == graph.StrategyDSatur {
+			coloring, err = graph.ColorVertices(newUndirectedGraph(), strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if coloring.NumColors() != 2 {
+				t.Fatalf("strategy %d: want 2 colors for tree, got %d", strategy, coloring.NumColors())
+			}
+		}
+	}
+
+	// DSatur is exact for bipartite graphs
+	coloring, err := graph.ColorVertices(cycle, graph.StrategyDSatur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coloring.NumColors() != 2 {
+		t.Fatalf("want 2 colors for even cycle, got %d", coloring.NumColors())
+	}
+
+	// Random graphs use at most one more color than the maximum
+	// degree
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		g := newRandomUndirectedGraph(r, 20, r.Float64())
+		maxDegree := 0
+		for _, v := range g.GetVertices() {
+			maxDegree = max(maxDegree, v.Degree.Out)
+		}
+
+		for _, strategy := range coloringStrategies {
+			coloring, err := graph.ColorVertices(g, strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !graph.IsValidColoring(g, coloring) {
+				t.Fatalf("strategy %d: invalid coloring", strategy)
+			}
+			if coloring.NumColors() > maxDegree+1 {
+				t.Fatalf("strategy %d: want at most %d colors, got %d", strategy, maxDegree+1, coloring.NumColors())
+			}
+		}
+	}
+
+	// Edges of directed graphs are treated as undirected
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	d.AddEdge(3, 1)
+	coloring, err = graph.ColorVertices(d, graph.StrategyDSatur)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !graph.IsValidColoring(d, coloring) || coloring.NumColors() != 3 {
+		t.Fatalf("want valid coloring with 3 colors, got %v", coloring)
+	}
+
+	// Unknown strategy
+	if _, err := graph.ColorVertices(d, graph.ColoringStrategy(42)); err == nil {
+		t.Fatal("ColorVertices: should fail with unknown strategy")
+	}
+}
+
+func TestIsValidColoring(t *testing.T) {
+	g := newPathGraph()
+	if !graph.IsValidColoring(g, graph.VertexColoring[int]{1: 0, 2: 1, 3: 0, 4: 1, 5: 0}) {
+		t.Fatal("coloring must be valid")
+	}
+	if graph.IsValidColoring(g, graph.VertexColoring[int]{1: 0, 2: 0, 3: 1, 4: 0, 5: 1}) {
+		t.Fatal("adjacent vertices must not share the same color")
+	}
+	if graph.IsValidColoring(g, graph.VertexColoring[int]{1: 0, 2: 1}) {
+		t.Fatal("each vertex must be colored")
+	}
+}
+
+func TestPaintVertexColoring(t *testing.T) {
+	g := newPathGraph()
+	coloring, err := graph.ColorVertices(g, graph.StrategyLargestFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := graph.PaintVertexColoring(g, coloring); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range g.GetVertices() {
+		want := graph.DotColorPalette[coloring[v.Value]]
+		if got := v.DotAttributes["fillcolor"]; got != want {
+			t.Fatalf("want fill color %s for vertex %d, got %s", want, v.Value, got)
+		}
+		if got := v.DotAttributes["style"]; got != "filled" {
+			t.Fatalf("want style filled for vertex %d, got %s", v.Value, got)
+		}
+	}
+
+	// Negative colors are rejected without painting any vertex
+	g = newPathGraph()
+	coloring = graph.VertexColoring[int]{1: 0, 2: -1}
+	if err := graph.PaintVertexColoring(g, coloring); err == nil {
+		t.Fatal("expected error for negative color")
+	}
+	for _, v := range g.GetVertices() {
+		if len(v.DotAttributes) != 0 {
+			t.Fatalf("vertex %d must not be painted", v.Value)
+		}
+	}
+}
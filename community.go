@@ -0,0 +1,504 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// ErrZeroTotalWeight is returned whenever an operation cannot be
+// performed, because the sum of the edge weights in the graph is
+// zero.
+var ErrZeroTotalWeight = errors.New("total edge weight is zero")
+
+// CommunityOptions represents the options used when detecting
+// communities in a graph.
+type CommunityOptions struct {
+	// Weighted specifies whether the edge weights are used. When
+	// false, each edge has a weight of one.
+	Weighted bool
+
+	// Resolution is the resolution parameter of the modularity.
+	// Values smaller than one favour larger communities, while
+	// values larger than one favour smaller communities.
+	Resolution float64
+
+	// Threshold is the minimum increase of modularity, which is
+	// required in order to continue with the next pass of the
+	// Louvain algorithm.
+	Threshold float64
+
+	// Seed is used to initialize the random number generator,
+	// which determines the order in which vertices are visited.
+	// The same seed yields the same communities for the same
+	// graph, as long as vertices without edges have distinct
+	// string representations.
+	Seed int64
+}
+
+// DefaultCommunityOptions returns the default options for detecting
+// communities.
+func DefaultCommunityOptions() *CommunityOptions {
+	opts := &CommunityOptions{
+		Weighted:   false,
+		Resolution: 1.0,
+		Threshold:  1.0e-7,
+		Seed:       0,
+	}
+
+	return opts
+}
+
+// communityGraph is a compact weighted representation of a graph,
+// which is used while detecting communities. For undirected graphs
+// the out field contains the weights of the edges in both directions,
+// and self-loops are stored once.
+//
+// Once all edges are added, freeze computes the sorted neighbours and
+// the weighted degrees of each vertex, so that the results do not
+// depend on the iteration order of maps.
+type communityGraph struct {
+	directed  bool
+	out       []map[int]float64
+	in        []map[int]float64
+	m         float64
+	outKeys   [][]int
+	inKeys    [][]int
+	outDegree []float64
+	inDegree  []float64
+}
+
+// newCommunityGraph creates a new community graph with N vertices
+func newCommunityGraph(n int, directed bool) *communityGraph {
+	cg := &communityGraph{
+		directed: directed,
+		out:      make([]map[int]float64, n),
+		in:       make([]map[int]float64, n),
+	}
+	for i := 0; i < n; i++ {
+		cg.out[i] = make(map[int]float64)
+		cg.in[i] = make(map[int]float64)
+	}
+
+	return cg
+}
+
+// sortedKeys returns the keys of the map in increasing order, so that
+// iterating over them does not depend on the iteration order of maps.
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// addWeight adds weight W to the edge connecting U and V
+func (cg *communityGraph) addWeight(u, v int, w float64) {
+	cg.m += w
+	cg.out[u][v] += w
+	if cg.directed {
+		cg.in[v][u] += w
+	} else if u != v {
+		cg.out[v][u] += w
+	}
+}
+
+// freeze computes the sorted neighbours and the weighted out- and
+// in-degree of each vertex. Both degrees are equal for undirected
+// graphs, where self-loops are counted twice.
+func (cg *communityGraph) freeze() {
+	n := len(cg.out)
+	cg.outKeys = make([][]int, n)
+	cg.inKeys = make([][]int, n)
+	cg.outDegree = make([]float64, n)
+	cg.inDegree = make([]float64, n)
+	for u := 0; u < n; u++ {
+		cg.outKeys[u] = sortedKeys(cg.out[u])
+		for _, v := range cg.outKeys[u] {
+			cg.outDegree[u] += cg.out[u][v]
+		}
+
+		if !cg.directed {
+			cg.outDegree[u] += cg.out[u][u]
+			cg.inDegree[u] = cg.outDegree[u]
+			continue
+		}
+
+		cg.inKeys[u] = sortedKeys(cg.in[u])
+		for _, v := range cg.inKeys[u] {
+			cg.inDegree[u] += cg.in[u][v]
+		}
+	}
+}
+
+// modularity computes the modularity of the given partition of the
+// community graph.
+func (cg *communityGraph) modularity(partition []int, resolution float64) float64 {
+	internal := 0.0
+	outTotal := make(map[int]float64)
+	inTotal := make(map[int]float64)
+	for u := range cg.out {
+		c := partition[u]
+		outTotal[c] += cg.outDegree[u]
+		inTotal[c] += cg.inDegree[u]
+		for _, v := range cg.outKeys[u] {
+			w := cg.out[u][v]
+			if partition[v] != c {
+				continue
+			}
+			if cg.directed || u == v {
+				internal += w
+			} else {
+				// Counted once from each side
+				internal += w / 2
+			}
+		}
+	}
+
+	q := internal / cg.m
+	norm := cg.m * cg.m
+	if !cg.directed {
+		norm *= 4
+	}
+	for _, c := range sortedKeys(outTotal) {
+		q -= resolution * outTotal[c] * inTotal[c] / norm
+	}
+
+	return q
+}
+
+// newCommunityGraphFrom creates a community graph from the given graph
+func newCommunityGraphFrom[T comparable](g Graph[T], idx *vertexIndex[T], weighted bool) (*communityGraph, error) {
+	cg := newCommunityGraph(len(idx.values), g.Kind() == KindDirected)
+	for _, e := range g.GetEdges() {
+		w := 1.0
+		if weighted {
+			if e.Weight < 0 {
+				return nil, fmt.Errorf("Negative weight for edge %v-%v", e.From, e.To)
+			}
+			w = e.Weight
+		}
+		cg.addWeight(idx.index[e.From], idx.index[e.To], w)
+	}
+	cg.freeze()
+
+	return cg, nil
+}
+
+// Modularity computes the modularity of the given partition of the
+// vertices in the graph. The partition maps each vertex to the
+// community it belongs to. For directed graphs the directed
+// modularity of Leicht and Newman is computed.
+//
+// When opts is nil, the options returned by DefaultCommunityOptions
+// are used.
+func Modularity[T comparable](g Graph[T], partition map[T]int, opts *CommunityOptions) (float64, error) {
+	if opts == nil {
+		opts = DefaultCommunityOptions()
+	}
+
+	idx := newStableVertexIndex(g)
+	cg, err := newCommunityGraphFrom(g, idx, opts.Weighted)
+	if err != nil {
+		return 0, err
+	}
+	if cg.m == 0 {
+		return 0, ErrZeroTotalWeight
+	}
+
+	communities := make([]int, len(idx.values))
+	for i, v := range idx.values {
+		c, ok := partition[v]
+		if !ok {
+			return 0, fmt.Errorf("Vertex %v not found in the partition", v)
+		}
+		communities[i] = c
+	}
+
+	return cg.modularity(communities, opts.Resolution), nil
+}
+
+// Louvain detects communities in the graph using the Louvain method,
+// which greedily optimizes the modularity of the partition. The
+// result maps each vertex to its community, numbered from zero.
+//
+// When opts is nil, the options returned by DefaultCommunityOptions
+// are used.
+func Louvain[T comparable](g Graph[T], opts *CommunityOptions) (map[T]int, error) {
+	if opts == nil {
+		opts = DefaultCommunityOptions()
+	}
+
+	idx := newStableVertexIndex(g)
+	n := len(idx.values)
+	cg, err := newCommunityGraphFrom(g, idx, opts.Weighted)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each vertex starts in its own community
+	membership := make([]int, n)
+	for i := range membership {
+		membership[i] = i
+	}
+
+	if cg.m == 0 {
+		return relabelCommunities(idx, membership), nil
+	}
+
+	r := rand.New(rand.NewSource(opts.Seed))
+	mod := cg.modularity(membership, opts.Resolution)
+
+	for {
+		partition, moved := louvainOneLevel(cg, opts.Resolution, r)
+		if !moved {
+			break
+		}
+
+		newMod := cg.modularity(partition, opts.Resolution)
+		for i, c := range membership {
+			membership[i] = partition[c]
+		}
+		if newMod-mod <= opts.Threshold {
+			break
+		}
+		mod = newMod
+		cg = cg.aggregate(partition)
+	}
+
+	return relabelCommunities(idx, membership), nil
+}
+
+// louvainOneLevel performs a single pass of local moves, where each
+// vertex is moved to the neighbouring community, which results in
+// the largest increase of modularity. Returns the resulting
+// partition, with communities numbered consecutively from zero, and
+// a boolean indicating whether any vertex has been moved.
+func louvainOneLevel(cg *communityGraph, resolution float64, r *rand.Rand) ([]int, bool) {
+	n := len(cg.out)
+	community := make([]int, n)
+	outDegree := cg.outDegree
+	inDegree := cg.inDegree
+	totalOut := slices.Clone(outDegree)
+	totalIn := slices.Clone(inDegree)
+	for u := 0; u < n; u++ {
+		community[u] = u
+	}
+
+	// The expected weight between vertex U and community C
+	m := cg.m
+	expected := func(u, c int) float64 {
+		if cg.directed {
+			return resolution * (outDegree[u]*totalIn[c] + inDegree[u]*totalOut[c]) / (m * m)
+		}
+		return resolution * outDegree[u] * totalOut[c] / (2 * m * m)
+	}
+
+	order := r.Perm(n)
+	moved := false
+	for {
+		moves := 0
+		for _, u := range order {
+			// Sum up the weights from U to each of the
+			// neighbouring communities
+			weights := make(map[int]float64)
+			for _, v := range cg.outKeys[u] {
+				if v != u {
+					weights[community[v]] += cg.out[u][v]
+				}
+			}
+			if cg.directed {
+				for _, v := range cg.inKeys[u] {
+					if v != u {
+						weights[community[v]] += cg.in[u][v]
+					}
+				}
+			}
+
+			// Remove U from its community
+			best := community[u]
+			totalOut[best] -= outDegree[u]
+			totalIn[best] -= inDegree[u]
+			removeCost := -weights[best]/m + expected(u, best)
+
+			// Ties are broken in favour of the community
+			// with the lowest number
+			bestGain := 0.0
+			for _, c := range sortedKeys(weights) {
+				gain := removeCost + weights[c]/m - expected(u, c)
+				if gain > bestGain {
+					bestGain = gain
+					best = c
+				}
+			}
+
+			// Insert U into the best community
+			totalOut[best] += outDegree[u]
+			totalIn[best] += inDegree[u]
+			if best != community[u] {
+				community[u] = best
+				moves++
+				moved = true
+			}
+		}
+
+		if moves == 0 {
+			break
+		}
+	}
+
+	// Renumber the communities consecutively
+	renumber := make(map[int]int)
+	for u, c := range community {
+		if _, ok := renumber[c]; !ok {
+			renumber[c] = len(renumber)
+		}
+		community[u] = renumber[c]
+	}
+
+	return community, moved
+}
+
+// aggregate creates a new community graph, where each vertex
+// represents a community of the given partition.
+func (cg *communityGraph) aggregate(partition []int) *communityGraph {
+	n := 0
+	for _, c := range partition {
+		n = max(n, c+1)
+	}
+
+	result := newCommunityGraph(n, cg.directed)
+	for u, items := range cg.out {
+		for _, v := range cg.outKeys[u] {
+			// Edges of undirected graphs are stored in
+			// both directions, so add them only once.
+			if !cg.directed && v < u {
+				continue
+			}
+			result.addWeight(partition[u], partition[v], items[v])
+		}
+	}
+	result.freeze()
+
+	return result
+}
+
+// relabelCommunities maps each vertex to its community, numbering
+// communities consecutively from zero.
+func relabelCommunities[T comparable](idx *vertexIndex[T], membership []int) map[T]int {
+	renumber := make(map[int]int)
+	result := make(map[T]int, len(idx.values))
+	for i, v := range idx.values {
+		c := membership[i]
+		if _, ok := renumber[c]; !ok {
+			renumber[c] = len(renumber)
+		}
+		result[v] = renumber[c]
+	}
+
+	return result
+}
+
+// LabelPropagation detects communities in the graph using the
+// asynchronous label propagation algorithm. Each vertex repeatedly
+// adopts the label, which is most frequent among its neighbours,
+// until every vertex has a label that is most frequent among its
+// neighbours. For directed graphs both the predecessors and the
+// successors of a vertex are considered as neighbours.
+//
+// The seed is used to initialize the random number generator, which
+// determines the order in which vertices are visited and how ties are
+// broken, so that the same seed yields the same communities for the
+// same graph. Vertices without edges are numbered in the order
+// of their string representation, which must be distinct for the
+// numbering to be reproducible. The result maps each vertex to its
+// community, numbered from zero.
+func LabelPropagation[T comparable](g Graph[T], seed int64) (map[T]int, error) {
+	idx := newStableVertexIndex(g)
+	n := len(idx.values)
+	neighbours := make([][]int, n)
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from == to {
+			continue
+		}
+		neighbours[from] = append(neighbours[from], to)
+		neighbours[to] = append(neighbours[to], from)
+	}
+
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for {
+		changed := false
+		for _, u := range r.Perm(n) {
+			if len(neighbours[u]) == 0 {
+				continue
+			}
+
+			// Find the most frequent labels among the
+			// neighbours of U
+			counts := make(map[int]int)
+			maxCount := 0
+			for _, v := range neighbours[u] {
+				counts[labels[v]]++
+				maxCount = max(maxCount, counts[labels[v]])
+			}
+
+			// Keep the current label, if it is one of the
+			// most frequent ones
+			if counts[labels[u]] == maxCount {
+				continue
+			}
+
+			candidates := make([]int, 0)
+			for _, v := range neighbours[u] {
+				l := labels[v]
+				if counts[l] == maxCount {
+					candidates = append(candidates, l)
+					counts[l] = -1
+				}
+			}
+			labels[u] = candidates[r.Intn(len(candidates))]
+			changed = true
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	return relabelCommunities(idx, labels), nil
+}
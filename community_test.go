@@ -0,0 +1,264 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"maps"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Creates a new undirected graph of two triangles, which are
+// connected by a single edge
+func newTwoTrianglesGraph() graph.Graph[int] {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 4)
+	g.AddEdge(3, 4)
+
+	return g
+}
+
+// Creates a new undirected graph of cliques, which are connected in
+// a ring
+func newRingOfCliquesGraph(cliques, size int) graph.Graph[int] {
+	g := graph.New[int](graph.KindUndirected)
+	for c := 0; c < cliques; c++ {
+		for i := 0; i < size; i++ {
+			for j := i + 1; j < size; j++ {
+				g.AddEdge(c*size+i, c*size+j)
+			}
+		}
+		g.AddEdge(c*size, ((c+1)%cliques)*size+1)
+	}
+
+	return g
+}
+
+// A helper function which verifies that the vertices from each group
+// belong to the same community, and that different groups belong to
+// different communities
+func verifyCommunities[T comparable](t *testing.T, want [][]T, got map[T]int) {
+	seen := make(map[int]bool)
+	for _, group := range want {
+		c := got[group[0]]
+		if seen[c] {
+			t.Fatalf("community %d is shared by multiple groups: %v", c, got)
+		}
+		seen[c] = true
+		for _, v := range group {
+			if got[v] != c {
+				t.Fatalf("vertex %v is not in the same community as %v: %v", v, group[0], got)
+			}
+		}
+	}
+}
+
+func TestModularity(t *testing.T) {
+	g := newTwoTrianglesGraph()
+	partition := map[int]int{1: 0, 2: 0, 3: 0, 4: 1, 5: 1, 6: 1}
+	got, err := graph.Modularity(g, partition, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 6.0/7 - 0.5; math.Abs(got-want) > 1.0e-9 {
+		t.Fatalf("want modularity %.6f, got %.6f", want, got)
+	}
+
+	single := map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0, 6: 0}
+	got, err = graph.Modularity(g, single, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got) > 1.0e-9 {
+		t.Fatalf("want modularity 0, got %.6f", got)
+	}
+
+	// Directed modularity
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 1)
+	d.AddEdge(3, 4)
+	d.AddEdge(4, 3)
+	got, err = graph.Modularity(d, map[int]int{1: 0, 2: 0, 3: 1, 4: 1}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got-0.5) > 1.0e-9 {
+		t.Fatalf("want modularity 0.5, got %.6f", got)
+	}
+
+	// Missing vertex in the partition
+	if _, err := graph.Modularity(g, map[int]int{1: 0}, nil); err == nil {
+		t.Fatal("Modularity: should fail with incomplete partition")
+	}
+
+	// Edges without weights
+	opts := graph.DefaultCommunityOptions()
+	opts.Weighted = true
+	if _, err := graph.Modularity(g, partition, opts); err != graph.ErrZeroTotalWeight {
+		t.Fatal("Modularity: should fail with zero total weight")
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	got, err := graph.Louvain(newTwoTrianglesGraph(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyCommunities(t, [][]int{{1, 2, 3}, {4, 5, 6}}, got)
+
+	g := newRingOfCliquesGraph(6, 5)
+	got, err = graph.Louvain(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([][]int, 0)
+	for c := 0; c < 6; c++ {
+		want = append(want, []int{c * 5, c*5 + 1, c*5 + 2, c*5 + 3, c*5 + 4})
+	}
+	verifyCommunities(t, want, got)
+
+	// Heavy edges pull vertices together
+	w := graph.New[int](graph.KindUndirected)
+	w.AddWeightedEdge(1, 2, 10)
+	w.AddWeightedEdge(3, 4, 10)
+	w.AddWeightedEdge(2, 3, 1)
+	w.AddWeightedEdge(1, 4, 1)
+	opts := graph.DefaultCommunityOptions()
+	opts.Weighted = true
+	got, err = graph.Louvain(w, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyCommunities(t, [][]int{{1, 2}, {3, 4}}, got)
+
+	// Directed graph of two cycles connected by a single edge
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	d.AddEdge(3, 1)
+	d.AddEdge(4, 5)
+	d.AddEdge(5, 6)
+	d.AddEdge(6, 4)
+	d.AddEdge(3, 4)
+	got, err = graph.Louvain(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyCommunities(t, [][]int{{1, 2, 3}, {4, 5, 6}}, got)
+
+	// Graph without edges
+	empty := graph.New[int](graph.KindUndirected)
+	empty.AddVertex(1)
+	empty.AddVertex(2)
+	got, err = graph.Louvain(empty, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyCommunities(t, [][]int{{1}, {2}}, got)
+}
+
+func TestLabelPropagation(t *testing.T) {
+	g := graph.New[int](graph.KindUndirected)
+	for _, base := range []int{0, 10} {
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				g.AddEdge(base+i, base+j)
+			}
+		}
+	}
+	g.AddVertex(42)
+
+	for seed := int64(0); seed < 10; seed++ {
+		got, err := graph.LabelPropagation(g, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyCommunities(t, [][]int{{0, 1, 2, 3}, {10, 11, 12, 13}, {42}}, got)
+	}
+}
+
+func TestCommunitiesReproducible(t *testing.T) {
+	// A ring of twelve vertices with a chord, which has many
+	// partitions of equal quality
+	ring := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 12; i++ {
+		ring.AddEdge(i, (i+1)%12)
+	}
+	ring.AddEdge(0, 6)
+	ring.AddVertex(12)
+	ring.AddVertex(13)
+
+	r := rand.New(rand.NewSource(29))
+	graphs := []graph.Graph[int]{
+		ring,
+		newRandomUndirectedGraph(r, 20, 0.2),
+		newRandomDirectedGraph(r, 20, 0.2, false),
+	}
+
+	for i, g := range graphs {
+		for seed := int64(0); seed < 5; seed++ {
+			opts := graph.DefaultCommunityOptions()
+			opts.Seed = seed
+			opts.Weighted = seed%2 == 0
+			want, err := graph.Louvain(g, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j := 0; j < 10; j++ {
+				got, err := graph.Louvain(g, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !maps.Equal(want, got) {
+					t.Fatalf("graph %d: Louvain with seed %d: want %v, got %v", i, seed, want, got)
+				}
+			}
+
+			want, err = graph.LabelPropagation(g, seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j := 0; j < 10; j++ {
+				got, err := graph.LabelPropagation(g, seed)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !maps.Equal(want, got) {
+					t.Fatalf("graph %d: LabelPropagation with seed %d: want %v, got %v", i, seed, want, got)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// spanningTree represents a rooted spanning tree of the vertices
+// reachable from its root, in terms of vertex positions
+type spanningTree struct {
+	// The parent of each vertex, or -1 for the root and the
+	// unreachable vertices
+	parent []int
+
+	// The number of edges between each vertex and the root
+	depth []int
+
+	// Whether each vertex is reachable from the root
+	reached []bool
+}
+
+// newShortestPathTree creates the tree of shortest paths from the
+// given root, using either BFS or Dijkstra's algorithm
+func newShortestPathTree[T comparable](arcs [][]indexedArc[T], root int, weighted bool) *spanningTree {
+	sp := singleSourceShortestPaths(arcs, root, weighted)
+	n := len(arcs)
+	tree := &spanningTree{
+		parent:  make([]int, n),
+		depth:   make([]int, n),
+		reached: make([]bool, n),
+	}
+	for v := range tree.parent {
+		tree.parent[v] = -1
+	}
+
+	// Parents are visited before their children
+	for _, v := range sp.order {
+		tree.reached[v] = true
+		if v != root {
+			p := sp.preds[v][0]
+			tree.parent[v] = p
+			tree.depth[v] = tree.depth[p] + 1
+		}
+	}
+
+	return tree
+}
+
+// isTreeEdge returns a boolean indicating whether the edge between
+// the two vertices is part of the tree
+func (t *spanningTree) isTreeEdge(u, v int) bool {
+	return t.parent[u] == v || t.parent[v] == u
+}
+
+// fundamentalCycle returns the vertices along the cycle formed by the
+// tree path between the two vertices, and the edge connecting them
+func (t *spanningTree) fundamentalCycle(u, v int) []int {
+	left := []int{u}
+	right := []int{v}
+	for u != v {
+		if t.depth[u] >= t.depth[v] {
+			u = t.parent[u]
+			left = append(left, u)
+		} else {
+			v = t.parent[v]
+			right = append(right, v)
+		}
+	}
+
+	// Both halves end at the common ancestor
+	right = right[:len(right)-1]
+	slices.Reverse(right)
+
+	return append(left, right...)
+}
+
+// cycleEdges returns the positions of the edges along the cycle
+func cycleEdges(cycle []int, edgeIndex map[[2]int]int) []int {
+	result := make([]int, 0, len(cycle))
+	for i, v := range cycle {
+		u := cycle[(i+1)%len(cycle)]
+		result = append(result, edgeIndex[[2]int{v, u}])
+	}
+
+	return result
+}
+
+// undirectedEdgeIndex returns the position of each edge in the list
+// of edges, keyed by the positions of its vertices in both directions
+func undirectedEdgeIndex[T comparable](g Graph[T], idx *vertexIndex[T]) map[[2]int]int {
+	result := make(map[[2]int]int, 2*len(g.GetEdges()))
+	for k, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		result[[2]int{from, to}] = k
+		result[[2]int{to, from}] = k
+	}
+
+	return result
+}
+
+// CycleBasis returns a basis of the cycle space of the undirected
+// graph, which consists of the fundamental cycles with respect to a
+// spanning forest of the graph. Each edge not in the forest forms a
+// cycle together with the path in the forest between its vertices.
+// Each cycle contains its vertices in order, without repeating the
+// first one at the end.
+//
+// Each element of the cycle space, i.e. each set of edges where every
+// vertex has an even degree, is the symmetric difference of some of
+// the cycles in the basis.
+func CycleBasis[T comparable](g Graph[T]) ([][]T, error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+
+	// Combine the BFS trees of the connected components into a
+	// forest
+	forest := &spanningTree{
+		parent:  make([]int, n),
+		depth:   make([]int, n),
+		reached: make([]bool, n),
+	}
+	for root := 0; root < n; root++ {
+		if forest.reached[root] {
+			continue
+		}
+		tree := newShortestPathTree(arcs, root, false)
+		for v := 0; v < n; v++ {
+			if tree.reached[v] {
+				forest.parent[v] = tree.parent[v]
+				forest.depth[v] = tree.depth[v]
+				forest.reached[v] = true
+			}
+		}
+	}
+
+	result := make([][]T, 0)
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from == to {
+			result = append(result, []T{e.From})
+			continue
+		}
+		if !forest.isTreeEdge(from, to) {
+			result = append(result, trailValues(idx, forest.fundamentalCycle(from, to)))
+		}
+	}
+
+	return result, nil
+}
+
+// MinimumCycleBasis returns a basis of the cycle space of the
+// undirected graph, with minimum total weight of the cycles. Each
+// cycle contains its vertices in order, without repeating the first
+// one at the end. Edge weights must be non-negative.
+//
+// The basis is selected greedily from the candidate cycles of
+// Horton, which are the fundamental cycles with respect to the
+// shortest path tree from each vertex, in order of increasing weight.
+// A candidate is kept, if it is linearly independent of the cycles
+// kept so far, which is tested by Gaussian elimination over GF(2).
+func MinimumCycleBasis[T comparable](g Graph[T]) ([][]T, error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+	if err := validateWeights(g); err != nil {
+		return nil, err
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	edges := g.GetEdges()
+	edgeIndex := undirectedEdgeIndex(g, idx)
+	n := len(idx.values)
+	m := len(edges)
+
+	// Self-loops are cycles on their own, which are independent
+	// of any other cycle
+	result := make([][]T, 0)
+	selfLoops := 0
+	for _, e := range edges {
+		if e.From == e.To {
+			result = append(result, []T{e.From})
+			selfLoops++
+		}
+	}
+
+	// The dimension of the cycle space is m - n + c
+	components := newDisjointSet(n)
+	count := n
+	for _, e := range edges {
+		if components.union(idx.index[e.From], idx.index[e.To]) {
+			count--
+		}
+	}
+	dimension := m - selfLoops - n + count
+	if dimension == 0 {
+		return result, nil
+	}
+
+	type candidate struct {
+		cycle  []int
+		weight float64
+	}
+	candidates := make([]candidate, 0)
+	seen := make(map[string]bool)
+	for root := 0; root < n; root++ {
+		tree := newShortestPathTree(arcs, root, true)
+		for _, e := range edges {
+			from, to := idx.index[e.From], idx.index[e.To]
+			if from == to || !tree.reached[from] || tree.isTreeEdge(from, to) {
+				continue
+			}
+
+			cycle := tree.fundamentalCycle(from, to)
+			key := newBitset(m)
+			weight := 0.0
+			for _, k := range cycleEdges(cycle, edgeIndex) {
+				key.set(k)
+				weight += edges[k].Weight
+			}
+
+			// The same cycle is often found from several
+			// roots
+			if s := fmt.Sprint(key); !seen[s] {
+				seen[s] = true
+				candidates = append(candidates, candidate{cycle: cycle, weight: weight})
+			}
+		}
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		switch {
+		case a.weight < b.weight:
+			return -1
+		case a.weight > b.weight:
+			return 1
+		}
+		return len(a.cycle) - len(b.cycle)
+	})
+
+	// Reduced basis vectors, keyed by their lowest set bit
+	pivots := make(map[int]bitset)
+	for _, c := range candidates {
+		vector := newBitset(m)
+		for _, k := range cycleEdges(c.cycle, edgeIndex) {
+			vector.set(k)
+		}
+
+		for {
+			low := vector.lowest()
+			if low == -1 {
+				break
+			}
+			pivot, ok := pivots[low]
+			if !ok {
+				pivots[low] = vector
+				result = append(result, trailValues(idx, c.cycle))
+				break
+			}
+			vector.xor(pivot)
+		}
+
+		if len(pivots) == dimension {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// Girth returns the number of edges in the shortest cycle of the
+// graph, or zero if the graph is acyclic. In undirected graphs a
+// cycle consists of at least three edges, unless it is a self-loop.
+func Girth[T comparable](g Graph[T]) int {
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+	undirected := g.Kind() == KindUndirected
+
+	girth := math.MaxInt
+	for _, e := range g.GetEdges() {
+		if e.From == e.To {
+			return 1
+		}
+	}
+
+	for root := 0; root < n; root++ {
+		tree := newShortestPathTree(arcs, root, false)
+		for _, e := range g.GetEdges() {
+			from, to := idx.index[e.From], idx.index[e.To]
+			if !tree.reached[from] {
+				continue
+			}
+			if undirected {
+				// A non-tree edge closes a cycle through
+				// the root, or a shorter one found from
+				// another root
+				if tree.reached[to] && !tree.isTreeEdge(from, to) {
+					girth = min(girth, tree.depth[from]+tree.depth[to]+1)
+				}
+			} else if to == root {
+				girth = min(girth, tree.depth[from]+1)
+			}
+		}
+	}
+
+	if girth == math.MaxInt {
+		return 0
+	}
+
+	return girth
+}
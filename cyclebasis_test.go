@@ -0,0 +1,253 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns the edges along the cycle as a
+// vector over GF(2), along with the total weight of the cycle. The
+// graph must have at most 64 edges.
+func cycleVector(t *testing.T, g graph.Graph[int], cycle []int) (uint64, float64) {
+	edges := g.GetEdges()
+	vector := uint64(0)
+	weight := 0.0
+	for i, v := range cycle {
+		u := cycle[(i+1)%len(cycle)]
+		e := g.GetEdge(v, u)
+		if e == nil {
+			t.Fatalf("no edge between %v and %v", v, u)
+		}
+		bit := uint64(1) << slices.Index(edges, e)
+		if vector&bit != 0 {
+			t.Fatalf("edge %v-%v appears twice in cycle %v", v, u, cycle)
+		}
+		vector |= bit
+		weight += e.Weight
+	}
+
+	return vector, weight
+}
+
+// A helper function which greedily selects the cycles, which are
+// linearly independent over GF(2), and returns their number and total
+// weight
+func independentCycles(t *testing.T, g graph.Graph[int], cycles [][]int) (int, float64) {
+	// Basis vectors keyed by their highest set bit
+	pivots := make(map[int]uint64)
+	total := 0.0
+	for _, cycle := range cycles {
+		vector, weight := cycleVector(t, g, cycle)
+		for vector != 0 {
+			high := bits.Len64(vector) - 1
+			pivot, ok := pivots[high]
+			if !ok {
+				pivots[high] = vector
+				total += weight
+				break
+			}
+			vector ^= pivot
+		}
+	}
+
+	return len(pivots), total
+}
+
+// A helper function which returns the dimension of the cycle space of
+// the undirected graph
+func cycleSpaceDimension(g graph.Graph[int]) int {
+	components := 0
+	seen := make(map[int]bool)
+	for _, v := range g.GetVertexValues() {
+		if seen[v] {
+			continue
+		}
+		components++
+		stack := []int{v}
+		seen[v] = true
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, w := range g.GetNeighbours(u) {
+				if !seen[w] {
+					seen[w] = true
+					stack = append(stack, w)
+				}
+			}
+		}
+	}
+
+	return len(g.GetEdges()) - len(g.GetVertices()) + components
+}
+
+func TestCycleBasis(t *testing.T) {
+	r := rand.New(rand.NewSource(43))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomUndirectedGraph(r, 10, 0.3)
+		g.AddEdge(2, 2)
+
+		basis, err := graph.CycleBasis(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := cycleSpaceDimension(g)
+		if len(basis) != want {
+			t.Fatalf("want %d cycles, got %d", want, len(basis))
+		}
+		if rank, _ := independentCycles(t, g, basis); rank != want {
+			t.Fatalf("want %d independent cycles, got %d", want, rank)
+		}
+	}
+
+	if _, err := graph.CycleBasis(graph.New[int](graph.KindDirected)); !errors.Is(err, graph.ErrIsNotUndirectedGraph) {
+		t.Fatalf("want ErrIsNotUndirectedGraph, got %v", err)
+	}
+}
+
+func TestMinimumCycleBasis(t *testing.T) {
+	// Square with a heavy diagonal, where the minimum basis
+	// consists of the square and one of the triangles
+	g := graph.New[int](graph.KindUndirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 1)
+	g.AddWeightedEdge(3, 4, 1)
+	g.AddWeightedEdge(4, 1, 1)
+	g.AddWeightedEdge(1, 3, 10)
+
+	basis, err := graph.MinimumCycleBasis(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(basis) != 2 {
+		t.Fatalf("want 2 cycles, got %d", len(basis))
+	}
+	if _, total := independentCycles(t, g, basis); total != 16 {
+		t.Fatalf("want total weight 16, got %v", total)
+	}
+
+	r := rand.New(rand.NewSource(43))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomUndirectedGraph(r, 8, 0.4)
+
+		// All cycles in order of increasing weight, from
+		// which the greedy selection gives a minimum basis
+		cycles := make([][]int, 0)
+		walkFunc := func(cycle []int) error {
+			cycles = append(cycles, cycle)
+			return nil
+		}
+		if err := graph.WalkCycles(g, nil, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		weight := func(cycle []int) float64 {
+			_, w := cycleVector(t, g, cycle)
+			return w
+		}
+		slices.SortStableFunc(cycles, func(a, b []int) int {
+			return int(weight(a) - weight(b))
+		})
+		wantRank, wantTotal := independentCycles(t, g, cycles)
+
+		basis, err := graph.MinimumCycleBasis(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(basis) != wantRank {
+			t.Fatalf("want %d cycles, got %d", wantRank, len(basis))
+		}
+		rank, total := independentCycles(t, g, basis)
+		if rank != wantRank {
+			t.Fatalf("want %d independent cycles, got %d", wantRank, rank)
+		}
+		if math.Abs(total-wantTotal) > 1e-9 {
+			t.Fatalf("want total weight %v, got %v", wantTotal, total)
+		}
+	}
+}
+
+func TestGirth(t *testing.T) {
+	petersen := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 5; i++ {
+		petersen.AddEdge(i, (i+1)%5)
+		petersen.AddEdge(i, i+5)
+		petersen.AddEdge(i+5, (i+2)%5+5)
+	}
+	if got := graph.Girth(petersen); got != 5 {
+		t.Fatalf("want girth 5, got %d", got)
+	}
+
+	tree := graph.New[int](graph.KindUndirected)
+	tree.AddEdge(1, 2)
+	tree.AddEdge(1, 3)
+	if got := graph.Girth(tree); got != 0 {
+		t.Fatalf("want girth 0, got %d", got)
+	}
+
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	d.AddEdge(3, 1)
+	d.AddEdge(3, 2)
+	if got := graph.Girth(d); got != 2 {
+		t.Fatalf("want girth 2, got %d", got)
+	}
+	d.AddEdge(1, 1)
+	if got := graph.Girth(d); got != 1 {
+		t.Fatalf("want girth 1, got %d", got)
+	}
+
+	r := rand.New(rand.NewSource(43))
+	for iter := 0; iter < 20; iter++ {
+		var g graph.Graph[int]
+		if iter%2 == 0 {
+			g = newRandomDirectedGraph(r, 8, 0.2, false)
+		} else {
+			g = newRandomUndirectedGraph(r, 8, 0.25)
+		}
+
+		want := 0
+		walkFunc := func(cycle []int) error {
+			if want == 0 || len(cycle) < want {
+				want = len(cycle)
+			}
+			return nil
+		}
+		if err := graph.WalkCycles(g, nil, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		if got := graph.Girth(g); got != want {
+			t.Fatalf("want girth %d, got %d", want, got)
+		}
+	}
+}
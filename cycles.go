@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import "slices"
+
+// CycleOptions represents the options used when walking the cycles of
+// a graph.
+type CycleOptions struct {
+	// MaxLength is the maximum number of vertices in the walked
+	// cycles. When negative, cycles of any length are walked.
+	MaxLength int
+
+	// MaxCount is the maximum number of cycles to walk. When
+	// negative, all cycles are walked.
+	MaxCount int
+}
+
+// DefaultCycleOptions returns the default options for walking the
+// cycles of a graph.
+func DefaultCycleOptions() *CycleOptions {
+	opts := &CycleOptions{
+		MaxLength: -1,
+		MaxCount:  -1,
+	}
+
+	return opts
+}
+
+// WalkCycles walks over the elementary cycles of the graph, using
+// Johnson's algorithm. An elementary cycle is a closed path, which
+// visits each of its vertices once. The walk function receives the
+// vertices along each cycle, without repeating the first one at the
+// end. A self-loop forms a cycle with a single vertex.
+//
+// In undirected graphs each cycle of at least three vertices is
+// walked once, in one of its two directions.
+//
+// Since the number of cycles may grow exponentially with the size of
+// the graph, the options allow limiting the length and number of the
+// walked cycles. The walk function may return ErrStopWalking to stop
+// walking early.
+func WalkCycles[T comparable](g Graph[T], opts *CycleOptions, walkFunc func(cycle []T) error) error {
+	if opts == nil {
+		opts = DefaultCycleOptions()
+	}
+
+	idx := newVertexIndex(g)
+	adj := idx.adjacency(g)
+	n := len(idx.values)
+	undirected := g.Kind() == KindUndirected
+	if opts.MaxLength == 0 || opts.MaxCount == 0 {
+		return nil
+	}
+	maxLength := opts.MaxLength
+	if maxLength < 0 {
+		maxLength = n
+	}
+	count := 0
+
+	blocked := make([]bool, n)
+	blockedBy := make([]map[int]bool, n)
+	unblock := func(v int) {
+		stack := []int{v}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !blocked[u] {
+				continue
+			}
+			blocked[u] = false
+			for w := range blockedBy[u] {
+				stack = append(stack, w)
+			}
+			blockedBy[u] = nil
+		}
+	}
+
+	for s := 0; s < n; s++ {
+		// Restrict the search to the strongly connected
+		// component of S among the vertices not considered
+		// yet, since all cycles through the previous vertices
+		// have been walked already. Self-loops of undirected
+		// graphs appear twice in the adjacency lists, so keep
+		// only one of them.
+		sub := make([][]int, n)
+		for v := s; v < n; v++ {
+			for _, u := range adj[v] {
+				if u < s || (u == v && slices.Contains(sub[v], v)) {
+					continue
+				}
+				sub[v] = append(sub[v], u)
+			}
+		}
+		comp, _ := stronglyConnectedComponents(sub)
+		for v := s; v < n; v++ {
+			keep := sub[v][:0]
+			for _, u := range sub[v] {
+				if comp[u] == comp[s] {
+					keep = append(keep, u)
+				}
+			}
+			sub[v] = keep
+		}
+
+		for v := s; v < n; v++ {
+			blocked[v] = false
+			blockedBy[v] = nil
+		}
+
+		// Iterative version of Johnson's search for cycles
+		// through S. Whenever a branch is cut short due to the
+		// options, it is considered closed, so that its
+		// vertices don't remain blocked.
+		path := []int{s}
+		next := []int{0}
+		closed := []bool{false}
+		blocked[s] = true
+		for len(path) > 0 {
+			top := len(path) - 1
+			v := path[top]
+			if next[top] < len(sub[v]) {
+				w := sub[v][next[top]]
+				next[top]++
+
+				switch {
+				case w == s:
+					closed[top] = true
+					if undirected && (len(path) == 2 || (len(path) > 2 && path[1] > path[top])) {
+						// Skip the reverse direction of
+						// the cycle, and the edge back
+						continue
+					}
+					err := walkFunc(trailValues(idx, path))
+					if err == ErrStopWalking {
+						return nil
+					}
+					if err != nil {
+						return err
+					}
+					count++
+					if opts.MaxCount >= 0 && count >= opts.MaxCount {
+						return nil
+					}
+				case len(path) >= maxLength:
+					closed[top] = true
+				case !blocked[w]:
+					path = append(path, w)
+					next = append(next, 0)
+					closed = append(closed, false)
+					blocked[w] = true
+				}
+				continue
+			}
+
+			// Done with V, backtrack
+			wasClosed := closed[top]
+			path = path[:top]
+			next = next[:top]
+			closed = closed[:top]
+			if wasClosed {
+				if top > 0 {
+					closed[top-1] = true
+				}
+				unblock(v)
+			} else {
+				for _, w := range sub[v] {
+					if blockedBy[w] == nil {
+						blockedBy[w] = make(map[int]bool)
+					}
+					blockedBy[w][v] = true
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns a canonical representation of a
+// cycle, by rotating its smallest vertex to the front. For undirected
+// graphs the smaller of both directions is used.
+func canonicalCycle(cycle []int, undirected bool) string {
+	start := slices.Index(cycle, slices.Min(cycle))
+	rotated := append(slices.Clone(cycle[start:]), cycle[:start]...)
+	if undirected && len(rotated) > 2 && rotated[1] > rotated[len(rotated)-1] {
+		slices.Reverse(rotated[1:])
+	}
+
+	return fmt.Sprint(rotated)
+}
+
+// A helper function which finds the elementary cycles of the graph
+// using plain backtracking from each smallest vertex of a cycle
+func bruteForceCycles(g graph.Graph[int], maxLength int) map[string]bool {
+	undirected := g.Kind() == graph.KindUndirected
+	result := make(map[string]bool)
+	for _, s := range g.GetVertexValues() {
+		var search func(path []int)
+		search = func(path []int) {
+			v := path[len(path)-1]
+			for _, u := range g.GetNeighbours(v) {
+				if u == s && len(path) <= maxLength && (!undirected || len(path) != 2) {
+					result[canonicalCycle(path, undirected)] = true
+				}
+				if u > s && !slices.Contains(path, u) && len(path) < maxLength {
+					search(append(slices.Clone(path), u))
+				}
+			}
+		}
+		search([]int{s})
+	}
+
+	return result
+}
+
+// A helper function which collects the cycles walked by WalkCycles
+func collectCycles(t *testing.T, g graph.Graph[int], opts *graph.CycleOptions) []string {
+	result := make([]string, 0)
+	walkFunc := func(cycle []int) error {
+		result = append(result, canonicalCycle(cycle, g.Kind() == graph.KindUndirected))
+		return nil
+	}
+	if err := graph.WalkCycles(g, opts, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func TestWalkCycles(t *testing.T) {
+	// Circular imports
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "a")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "c")
+	g.AddEdge("c", "d")
+
+	count := 0
+	walkFunc := func(cycle []string) error {
+		count++
+		for i, v := range cycle {
+			if !g.EdgeExists(v, cycle[(i+1)%len(cycle)]) {
+				t.Fatalf("no edge between %v and %v", v, cycle[(i+1)%len(cycle)])
+			}
+		}
+		return nil
+	}
+	if err := graph.WalkCycles(g, nil, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("want 3 cycles, got %d", count)
+	}
+
+	// Stop walking after the first cycle
+	count = 0
+	stopFunc := func(cycle []string) error {
+		count++
+		return graph.ErrStopWalking
+	}
+	if err := graph.WalkCycles(g, nil, stopFunc); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("want 1 cycle, got %d", count)
+	}
+
+	// Errors are propagated
+	errTest := errors.New("test error")
+	errFunc := func(cycle []string) error {
+		return errTest
+	}
+	if err := graph.WalkCycles(g, nil, errFunc); !errors.Is(err, errTest) {
+		t.Fatalf("want test error, got %v", err)
+	}
+
+	// Limit the number of cycles
+	opts := graph.DefaultCycleOptions()
+	opts.MaxCount = 2
+	count = 0
+	if err := graph.WalkCycles(g, opts, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("want 2 cycles, got %d", count)
+	}
+
+	// A limit of zero walks no cycles
+	opts.MaxCount = 0
+	count = 0
+	if err := graph.WalkCycles(g, opts, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Fatalf("want no cycles, got %d", count)
+	}
+}
+
+func TestWalkCyclesRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(41))
+	for iter := 0; iter < 40; iter++ {
+		var g graph.Graph[int]
+		if iter%2 == 0 {
+			g = newRandomDirectedGraph(r, 7, 0.3, false)
+		} else {
+			g = newRandomUndirectedGraph(r, 7, 0.4)
+		}
+		g.AddEdge(3, 3)
+
+		for _, maxLength := range []int{-1, 0, 1, 3, 5} {
+			opts := graph.DefaultCycleOptions()
+			opts.MaxLength = maxLength
+			got := collectCycles(t, g, opts)
+
+			limit := maxLength
+			if limit < 0 {
+				limit = 7
+			}
+			want := bruteForceCycles(g, limit)
+			if len(got) != len(want) {
+				t.Fatalf("want %d cycles of length up to %d, got %d", len(want), limit, len(got))
+			}
+			for _, cycle := range got {
+				if !want[cycle] {
+					t.Fatalf("unexpected cycle %v", cycle)
+				}
+			}
+		}
+	}
+}
@@ -34,6 +34,23 @@ import (
 // WalkPreOrderDFS performs pre-order Depth-first Search (DFS)
 // traversal of the graph, starting from the given source vertex.
 func WalkPreOrderDFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
+	return WalkPreOrderDFSWithOptions(g, source, nil, walkFunc)
+}
+
+// WalkPreOrderDFSWithOptions performs pre-order Depth-first Search
+// (DFS) traversal of the graph, starting from the given source vertex,
+// and bounded by the given options. When the options are nil, the
+// default options are used.
+//
+// Note, that the depth of a vertex is the length of the path through
+// which the traversal reached it first, which may be longer than the
+// shortest path. Use WalkBFSWithOptions or IterativeDeepeningDFS in
+// order to walk all vertices within a given number of edges.
+func WalkPreOrderDFSWithOptions[T comparable](g Graph[T], source T, opts *WalkOptions, walkFunc WalkFunc[T]) error {
+	if opts == nil {
+		opts = DefaultWalkOptions()
+	}
+
 	if !g.VertexExists(source) {
 		return fmt.Errorf("Source vertex %v not found in the graph", source)
 	}
@@ -54,8 +71,12 @@ func WalkPreOrderDFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) e
 			panic(err)
 		}
 
-		// Visit the neighbours of V
+		// Visit the neighbours of V, unless it is at the maximum
+		// depth
 		neighbours := g.GetNeighbourVertices(v.Value)
+		if !opts.canExplore(v.DistanceFromSource) {
+			neighbours = nil
+		}
 		for _, u := range neighbours {
 			// First time seeing this neighbour vertex,
 			// push it to the stack
@@ -140,6 +161,96 @@ func WalkPostOrderDFS[T comparable](g Graph[T], source T, walkFunc WalkFunc[T])
 	return nil
 }
 
+// depthFrame represents a vertex on the stack of a depth-limited
+// search, along with the depth at which it was reached
+type depthFrame[T comparable] struct {
+	vertex *Vertex[T]
+	parent *Vertex[T]
+	depth  int
+}
+
+// IterativeDeepeningDFS performs Iterative Deepening Depth-first
+// Search (IDDFS) traversal of the graph, starting from the given
+// source vertex and bounded by the given options. When the options are
+// nil, the default options are used.
+//
+// The traversal performs a depth-limited DFS with an increasing depth
+// limit, and walks the vertices at each depth as the limit reaches
+// it. Therefore the vertices are walked in order of increasing
+// distance from the source vertex, as with BFS, and each vertex is
+// walked once with DistanceFromSource set to the number of edges in a
+// shortest path to it.
+func IterativeDeepeningDFS[T comparable](g Graph[T], source T, opts *WalkOptions, walkFunc WalkFunc[T]) error {
+	if opts == nil {
+		opts = DefaultWalkOptions()
+	}
+
+	if !g.VertexExists(source) {
+		return fmt.Errorf("Source vertex %v not found in the graph", source)
+	}
+
+	// Make sure to reset all vertex attributes. Walked vertices
+	// are painted Black.
+	g.ResetVertexAttributes()
+
+	srcVertex := g.GetVertex(source)
+	for limit := 0; opts.canExplore(float64(limit - 1)); limit++ {
+		// The least depth at which each vertex was reached during
+		// the current iteration
+		reached := make(map[T]int)
+		found := false
+		stack := deque.New[depthFrame[T]]()
+		stack.PushFront(depthFrame[T]{vertex: srcVertex, depth: 0})
+
+		for !stack.IsEmpty() {
+			frame, err := stack.PopFront()
+			if err != nil {
+				panic(err)
+			}
+
+			v := frame.vertex
+			if depth, ok := reached[v.Value]; ok && depth <= frame.depth {
+				continue
+			}
+			reached[v.Value] = frame.depth
+
+			if frame.depth < limit {
+				for _, u := range g.GetNeighbourVertices(v.Value) {
+					if depth, ok := reached[u.Value]; !ok || depth > frame.depth+1 {
+						stack.PushFront(depthFrame[T]{vertex: u, parent: v, depth: frame.depth + 1})
+					}
+				}
+				continue
+			}
+
+			// Vertices at the depth limit are new, unless they
+			// were walked during a previous iteration
+			if v.Color == Black {
+				continue
+			}
+			found = true
+			v.Color = Black
+			v.DistanceFromSource = float64(frame.depth)
+			v.Parent = frame.parent
+
+			walkErr := walkFunc(v)
+			if walkErr == ErrStopWalking {
+				return nil
+			}
+			if walkErr != nil {
+				return walkErr
+			}
+		}
+
+		// No vertices left at this depth or beyond
+		if !found {
+			break
+		}
+	}
+
+	return nil
+}
+
 // WalkUnreachableVertices walks over the vertices which are
 // unreachable from the given source vertex
 func WalkUnreachableVertices[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
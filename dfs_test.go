@@ -27,6 +27,7 @@ package graph_test
 
 import (
 	"errors"
+	"math/rand"
 	"slices"
 	"testing"
 
@@ -325,3 +326,128 @@ func TestWalkUnreachableVertices(t *testing.T) {
 		t.Fatal("WalkUnreachableVertices is expected to return our custom error")
 	}
 }
+
+func TestWalkPreOrderDFSWithOptions(t *testing.T) {
+	g := newPathGraph()
+	collector := g.NewCollector()
+	opts := &graph.WalkOptions{MaxDepth: 2}
+	if err := graph.WalkPreOrderDFSWithOptions(g, 1, opts, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	gotValues := make([]int, 0)
+	for _, v := range collector.Get() {
+		gotValues = append(gotValues, v.Value)
+	}
+	if !slices.Equal([]int{1, 2, 3}, gotValues) {
+		t.Fatalf("want vertices [1 2 3], got %v", gotValues)
+	}
+
+	// A depth of zero walks the source vertex only
+	collector = g.NewCollector()
+	opts.MaxDepth = 0
+	if err := graph.WalkPreOrderDFSWithOptions(g, 3, opts, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if got := collector.Get(); len(got) != 1 || got[0].Value != 3 {
+		t.Fatalf("want the source vertex only, got %v", got)
+	}
+
+	// A negative depth does not limit the walk
+	collector = g.NewCollector()
+	opts.MaxDepth = -1
+	if err := graph.WalkPreOrderDFSWithOptions(g, 1, opts, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if got := collector.Get(); len(got) != 5 {
+		t.Fatalf("want 5 vertices, got %d", len(got))
+	}
+
+	if err := graph.WalkPreOrderDFSWithOptions(g, 42, opts, collector.WalkFunc); err == nil {
+		t.Fatal("expected error for unknown source vertex")
+	}
+}
+
+func TestIterativeDeepeningDFS(t *testing.T) {
+	r := rand.New(rand.NewSource(50))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(30) + 1
+		var g graph.Graph[int]
+		if i%2 == 0 {
+			g = newRandomDirectedGraph(r, n, 0.1, false)
+		} else {
+			g = newRandomUndirectedGraph(r, n, 0.1)
+		}
+		source := r.Intn(n)
+		hops := walkDistances(g, source, graph.WalkBFS[int])
+
+		var opts *graph.WalkOptions
+		maxDepth := float64(n)
+		if i%3 == 0 {
+			opts = &graph.WalkOptions{MaxDepth: r.Intn(4)}
+			maxDepth = float64(opts.MaxDepth)
+		}
+
+		visited := make(map[int]bool)
+		depth := 0.0
+		walkFunc := func(v *graph.Vertex[int]) error {
+			if visited[v.Value] {
+				t.Fatalf("graph %d: vertex %v walked twice", i, v.Value)
+			}
+			visited[v.Value] = true
+			if v.DistanceFromSource != hops[v.Value] || v.DistanceFromSource < depth {
+				t.Fatalf("graph %d: vertex %v: want depth %v, got %v", i, v.Value, hops[v.Value], v.DistanceFromSource)
+			}
+			if v.Value != source && (v.Parent == nil || v.Parent.DistanceFromSource != v.DistanceFromSource-1) {
+				t.Fatalf("graph %d: vertex %v has invalid parent", i, v.Value)
+			}
+			depth = v.DistanceFromSource
+			return nil
+		}
+		if err := graph.IterativeDeepeningDFS(g, source, opts, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		for v, d := range hops {
+			if visited[v] != (d <= maxDepth) {
+				t.Fatalf("graph %d: vertex %v at depth %v walked %v", i, v, d, visited[v])
+			}
+		}
+	}
+
+	// Short-circuit walking by signalling ErrStopWalking
+	g := newPathGraph()
+	result := make([]int, 0)
+	walkFunc := func(v *graph.Vertex[int]) error {
+		if v.Value == 4 {
+			return graph.ErrStopWalking
+		}
+		result = append(result, v.Value)
+		return nil
+	}
+	if err := graph.IterativeDeepeningDFS(g, 1, nil, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal([]int{1, 2, 3}, result) {
+		t.Fatalf("want vertices [1 2 3], got %v", result)
+	}
+
+	// A depth of zero walks the source vertex only
+	collector := g.NewCollector()
+	if err := graph.IterativeDeepeningDFS(g, 1, &graph.WalkOptions{MaxDepth: 0}, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if got := collector.Get(); len(got) != 1 || got[0].Value != 1 {
+		t.Fatalf("want the source vertex only, got %v", got)
+	}
+
+	// Signal custom error while walking
+	myErr := errors.New("my custom error")
+	errWalker := func(v *graph.Vertex[int]) error {
+		return myErr
+	}
+	if err := graph.IterativeDeepeningDFS(g, 1, nil, errWalker); err != myErr {
+		t.Fatal("IterativeDeepeningDFS should fail with custom error")
+	}
+	if err := graph.IterativeDeepeningDFS(g, 42, nil, errWalker); err == nil {
+		t.Fatal("expected error for unknown source vertex")
+	}
+}
@@ -85,6 +85,20 @@ func relaxEdge[T comparable](g Graph[T], from T, to T) error {
 // callers of this method should return ErrStopWalking error and refer
 // to the shortest-path tree, or use the WalkShortestPath method.
 func WalkDijkstra[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) error {
+	return WalkDijkstraWithOptions(g, source, nil, walkFunc)
+}
+
+// WalkDijkstraWithOptions implements Dijkstra's algorithm for finding
+// the shortest-path from a given source vertex to all other vertices
+// in the graph, bounded by the given options. Once the next vertex is
+// farther than the maximum cost, the walk stops, and the remaining
+// vertices keep their tentative distances. When the options are nil,
+// the default options are used.
+func WalkDijkstraWithOptions[T comparable](g Graph[T], source T, opts *WalkOptions, walkFunc WalkFunc[T]) error {
+	if opts == nil {
+		opts = DefaultWalkOptions()
+	}
+
 	if err := initializeSourceVertex(g, source); err != nil {
 		return err
 	}
@@ -98,6 +112,11 @@ func WalkDijkstra[T comparable](g Graph[T], source T, walkFunc WalkFunc[T]) erro
 	for !queue.IsEmpty() {
 		item := queue.Get()
 		v := item.Value
+		// All remaining vertices are farther than the maximum cost
+		if opts.MaxCost >= 0 && v.DistanceFromSource > opts.MaxCost {
+			return nil
+		}
+
 		// Relax edges connecting V and it's neighbours
 		for _, u := range g.GetNeighbourVertices(v.Value) {
 			oldDist := u.DistanceFromSource
@@ -27,6 +27,7 @@ package graph_test
 
 import (
 	"errors"
+	"math/rand"
 	"slices"
 	"testing"
 
@@ -132,3 +133,72 @@ func TestWalkShortestPath(t *testing.T) {
 		t.Fatal("WalkDijkstra should fail with non-existing vertex")
 	}
 }
+
+func TestWalkDijkstraWithOptions(t *testing.T) {
+	r := rand.New(rand.NewSource(50))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(30) + 1
+		var g graph.Graph[int]
+		if i%2 == 0 {
+			g = newRandomDirectedGraph(r, n, 0.1, false)
+		} else {
+			g = newRandomUndirectedGraph(r, n, 0.1)
+		}
+		source := r.Intn(n)
+		dist := walkDistances(g, source, graph.WalkDijkstra[int])
+
+		maxCost := float64(r.Intn(40))
+		opts := &graph.WalkOptions{MaxCost: maxCost}
+		visited := make(map[int]bool)
+		walkFunc := func(v *graph.Vertex[int]) error {
+			visited[v.Value] = true
+			if v.DistanceFromSource != dist[v.Value] {
+				t.Fatalf("graph %d: vertex %v: want distance %v, got %v", i, v.Value, dist[v.Value], v.DistanceFromSource)
+			}
+			return nil
+		}
+		if err := graph.WalkDijkstraWithOptions(g, source, opts, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range g.GetVertexValues() {
+			d, ok := dist[v]
+			if visited[v] != (ok && d <= maxCost) {
+				t.Fatalf("graph %d: vertex %v at distance %v walked %v with max cost %v", i, v, d, visited[v], maxCost)
+			}
+		}
+	}
+}
+
+func TestWalkDijkstraWithZeroCost(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddWeightedEdge(1, 2, 0)
+	g.AddWeightedEdge(2, 3, 0)
+	g.AddWeightedEdge(3, 4, 1)
+	g.AddWeightedEdge(1, 5, 2)
+
+	// A cost of zero walks the vertices reachable over edges of
+	// zero weight only
+	opts := graph.DefaultWalkOptions()
+	opts.MaxCost = 0
+	collector := g.NewCollector()
+	if err := graph.WalkDijkstraWithOptions(g, 1, opts, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	gotValues := make([]int, 0)
+	for _, v := range collector.Get() {
+		gotValues = append(gotValues, v.Value)
+	}
+	slices.Sort(gotValues)
+	if !slices.Equal([]int{1, 2, 3}, gotValues) {
+		t.Fatalf("want vertices [1 2 3], got %v", gotValues)
+	}
+
+	// The default options do not limit the walk
+	collector = g.NewCollector()
+	if err := graph.WalkDijkstraWithOptions(g, 1, graph.DefaultWalkOptions(), collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if got := collector.Get(); len(got) != 5 {
+		t.Fatalf("want 5 vertices, got %d", len(got))
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrNotEnoughDisjointPaths is returned whenever the graph does not
+// contain the requested number of disjoint paths.
+var ErrNotEnoughDisjointPaths = errors.New("not enough disjoint paths")
+
+// DisjointMode specifies which elements must not be shared by
+// disjoint paths
+type DisjointMode int
+
+const (
+	// EdgeDisjoint specifies that paths must not share edges
+	EdgeDisjoint DisjointMode = iota
+
+	// VertexDisjoint specifies that paths must not share vertices,
+	// other than the source and destination
+	VertexDisjoint
+)
+
+// pathArc maps an arc of a flow network to an edge of the graph in
+// the direction it is traversed
+type pathArc struct {
+	id       int
+	from, to int
+	weight   float64
+}
+
+// disjointPathsNetwork builds a flow network with unit capacities,
+// where each unit of flow from the source to the sink corresponds to
+// a path from S to T, and the paths are disjoint according to the
+// given mode. For vertex-disjoint paths, each vertex V other than S
+// and T is split into V and V+n, which are connected by an arc of
+// unit capacity.
+func disjointPathsNetwork[T comparable](g Graph[T], idx *vertexIndex[T], s, t int, mode DisjointMode) (*flowNetwork, int, int, []pathArc, error) {
+	n := len(idx.values)
+
+	var network *flowNetwork
+	source, sink := s, t
+	out := func(v int) int {
+		return v
+	}
+	switch mode {
+	case EdgeDisjoint:
+		network = newFlowNetwork(n)
+	case VertexDisjoint:
+		network = newFlowNetwork(2 * n)
+		for v := 0; v < n; v++ {
+			if v != s && v != t {
+				network.addArc(v, v+n, 1, 0)
+			}
+		}
+		source = s + n
+		out = func(v int) int {
+			return v + n
+		}
+	default:
+		return nil, 0, 0, nil, fmt.Errorf("Unknown disjoint mode %v", mode)
+	}
+
+	arcs := make([]pathArc, 0)
+	addArc := func(from, to int, weight float64) {
+		id := network.addArc(out(from), to, 1, weight)
+		arcs = append(arcs, pathArc{id: id, from: from, to: to, weight: weight})
+	}
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from == to {
+			continue
+		}
+		addArc(from, to, e.Weight)
+		if g.Kind() == KindUndirected {
+			addArc(to, from, e.Weight)
+		}
+	}
+
+	return network, source, sink, arcs, nil
+}
+
+// decomposeFlow splits the unit flow along the arcs into paths from S
+// to T. Flow in opposite directions along an undirected edge cancels
+// out, and cycles of flow are dropped, so that the resulting paths
+// are simple.
+func decomposeFlow(network *flowNetwork, arcs []pathArc, s, t, count int) [][]int {
+	used := make(map[[2]int]int)
+	for _, arc := range arcs {
+		if network.flow(arc.id) > 0 {
+			used[[2]int{arc.from, arc.to}]++
+		}
+	}
+	for pair, c := range used {
+		reverse := [2]int{pair[1], pair[0]}
+		if r := used[reverse]; r > 0 && c > 0 {
+			cancel := min(r, c)
+			used[pair] -= cancel
+			used[reverse] -= cancel
+		}
+	}
+
+	next := make(map[int][]int)
+	for _, arc := range arcs {
+		pair := [2]int{arc.from, arc.to}
+		if used[pair] > 0 {
+			used[pair]--
+			next[arc.from] = append(next[arc.from], arc.to)
+		}
+	}
+
+	paths := make([][]int, 0, count)
+	for i := 0; i < count; i++ {
+		path := []int{s}
+		for v := s; v != t; {
+			u := next[v][len(next[v])-1]
+			next[v] = next[v][:len(next[v])-1]
+			if pos := slices.Index(path, u); pos != -1 {
+				// Drop the cycle
+				path = path[:pos+1]
+			} else {
+				path = append(path, u)
+			}
+			v = u
+		}
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// DisjointShortestPaths returns K disjoint paths from the source to
+// the destination vertex with minimum total weight, along with their
+// total weight. Depending on the mode, the paths do not share any
+// edges, or any vertices other than the source and destination.
+//
+// The paths are found as a minimum cost flow with unit capacities,
+// which augments along shortest paths in the residual graph as in the
+// algorithms of Suurballe and Bhandari. Edge weights must be
+// non-negative and K must be at least one. Returns
+// ErrNotEnoughDisjointPaths, if there are less than K disjoint paths.
+func DisjointShortestPaths[T comparable](g Graph[T], source, dest T, k int, mode DisjointMode) ([][]T, float64, error) {
+	if !g.VertexExists(source) {
+		return nil, 0, fmt.Errorf("Source vertex %v not found in the graph", source)
+	}
+	if !g.VertexExists(dest) {
+		return nil, 0, fmt.Errorf("Destination vertex %v not found in the graph", dest)
+	}
+	if source == dest {
+		return nil, 0, fmt.Errorf("Source and destination vertex %v must be different", source)
+	}
+	if k < 1 {
+		return nil, 0, fmt.Errorf("Invalid number of paths %d", k)
+	}
+	if err := validateWeights(g); err != nil {
+		return nil, 0, err
+	}
+
+	idx := newVertexIndex(g)
+	s, t := idx.index[source], idx.index[dest]
+	network, from, to, arcs, err := disjointPathsNetwork(g, idx, s, t, mode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	flow, _ := network.minCostFlow(from, to, float64(k))
+	if int(flow) < k {
+		return nil, 0, fmt.Errorf("%w: want %d paths between %v and %v, found %d", ErrNotEnoughDisjointPaths, k, source, dest, int(flow))
+	}
+
+	weights := make(map[[2]int]float64, len(arcs))
+	for _, arc := range arcs {
+		weights[[2]int{arc.from, arc.to}] = arc.weight
+	}
+
+	result := make([][]T, 0, k)
+	total := 0.0
+	for _, path := range decomposeFlow(network, arcs, s, t, k) {
+		for i := 0; i+1 < len(path); i++ {
+			total += weights[[2]int{path[i], path[i+1]}]
+		}
+		result = append(result, trailValues(idx, path))
+	}
+
+	return result, total, nil
+}
+
+// localConnectivity returns the maximum number of disjoint paths
+// between the two vertices according to the given mode
+func localConnectivity[T comparable](g Graph[T], source, dest T, mode DisjointMode) (int, error) {
+	if !g.VertexExists(source) {
+		return 0, fmt.Errorf("Source vertex %v not found in the graph", source)
+	}
+	if !g.VertexExists(dest) {
+		return 0, fmt.Errorf("Destination vertex %v not found in the graph", dest)
+	}
+	if source == dest {
+		return 0, fmt.Errorf("Source and destination vertex %v must be different", source)
+	}
+
+	idx := newVertexIndex(g)
+	network, from, to, _, err := disjointPathsNetwork(g, idx, idx.index[source], idx.index[dest], mode)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(network.maxFlow(from, to)), nil
+}
+
+// LocalEdgeConnectivity returns the minimum number of edges, whose
+// removal disconnects the destination from the source vertex. By
+// Menger's theorem it equals the maximum number of edge-disjoint
+// paths between the vertices, which is computed as a maximum flow.
+func LocalEdgeConnectivity[T comparable](g Graph[T], source, dest T) (int, error) {
+	return localConnectivity(g, source, dest, EdgeDisjoint)
+}
+
+// LocalVertexConnectivity returns the maximum number of paths between
+// the source and destination vertex, which do not share any other
+// vertices. By Menger's theorem, for non-adjacent vertices it equals
+// the minimum number of other vertices, whose removal disconnects the
+// destination from the source. A direct edge between the vertices
+// counts as one of the paths.
+func LocalVertexConnectivity[T comparable](g Graph[T], source, dest T) (int, error) {
+	return localConnectivity(g, source, dest, VertexDisjoint)
+}
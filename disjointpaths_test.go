@@ -0,0 +1,289 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the paths lead from the
+// source to the destination, are disjoint according to the mode, and
+// have the given total weight
+func verifyDisjointPaths(t *testing.T, g graph.Graph[int], paths [][]int, source, dest int, mode graph.DisjointMode, weight float64) {
+	usedEdges := make(map[*graph.Edge[int]]bool)
+	usedVertices := make(map[int]bool)
+	total := 0.0
+	for _, path := range paths {
+		if path[0] != source || path[len(path)-1] != dest {
+			t.Fatalf("want path from %v to %v, got %v", source, dest, path)
+		}
+		for i, v := range path {
+			if i > 0 && i < len(path)-1 {
+				if mode == graph.VertexDisjoint && usedVertices[v] {
+					t.Fatalf("vertex %v is shared by paths %v", v, paths)
+				}
+				usedVertices[v] = true
+			}
+			if i+1 < len(path) {
+				e := g.GetEdge(v, path[i+1])
+				if e == nil {
+					t.Fatalf("no edge between %v and %v", v, path[i+1])
+				}
+				if usedEdges[e] {
+					t.Fatalf("edge %v-%v is shared by paths %v", e.From, e.To, paths)
+				}
+				usedEdges[e] = true
+				total += e.Weight
+			}
+		}
+	}
+	if math.Abs(total-weight) > 1e-9 {
+		t.Fatalf("want total weight %v, got %v", total, weight)
+	}
+}
+
+// A helper function which returns the minimum total weight of two
+// disjoint paths, by checking each pair of simple paths
+func bruteForceDisjointPair(t *testing.T, g graph.Graph[int], source, dest int, mode graph.DisjointMode) float64 {
+	paths := make([][]int, 0)
+	walkFunc := func(path []int) error {
+		paths = append(paths, path)
+		return nil
+	}
+	if err := graph.WalkAllSimplePaths(g, source, dest, nil, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	weight := func(path []int) (float64, map[*graph.Edge[int]]bool) {
+		edges := make(map[*graph.Edge[int]]bool)
+		total := 0.0
+		for i := 0; i+1 < len(path); i++ {
+			e := g.GetEdge(path[i], path[i+1])
+			edges[e] = true
+			total += e.Weight
+		}
+		return total, edges
+	}
+
+	best := math.Inf(1)
+	for i, a := range paths {
+		wa, ea := weight(a)
+		for _, b := range paths[i+1:] {
+			wb, eb := weight(b)
+			disjoint := true
+			for e := range eb {
+				if ea[e] {
+					disjoint = false
+				}
+			}
+			if mode == graph.VertexDisjoint {
+				for _, v := range b[1 : len(b)-1] {
+					if slices.Contains(a[1:len(a)-1], v) {
+						disjoint = false
+					}
+				}
+			}
+			if disjoint {
+				best = min(best, wa+wb)
+			}
+		}
+	}
+
+	return best
+}
+
+// A helper function which returns the minimum number of elements to
+// remove in order to disconnect the destination from the source, by
+// checking each subset of edges or of the other vertices
+func bruteForceConnectivity(g graph.Graph[int], source, dest int, mode graph.DisjointMode) int {
+	edges := g.GetEdges()
+	size := len(edges)
+	if mode == graph.VertexDisjoint {
+		size = len(g.GetVertices())
+	}
+
+	best := math.MaxInt
+	for mask := 0; mask < 1<<size; mask++ {
+		if mode == graph.VertexDisjoint && mask&(1<<source|1<<dest) != 0 {
+			continue
+		}
+		k := 0
+		for m := mask; m != 0; m &= m - 1 {
+			k++
+		}
+		if k >= best {
+			continue
+		}
+
+		removed := func(e *graph.Edge[int]) bool {
+			if mode == graph.EdgeDisjoint {
+				return mask&(1<<slices.Index(edges, e)) != 0
+			}
+			return mask&(1<<e.From) != 0 || mask&(1<<e.To) != 0
+		}
+		seen := map[int]bool{source: true}
+		stack := []int{source}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, u := range g.GetNeighbours(v) {
+				if !seen[u] && !removed(g.GetEdge(v, u)) {
+					seen[u] = true
+					stack = append(stack, u)
+				}
+			}
+		}
+		if !seen[dest] {
+			best = k
+		}
+	}
+
+	return best
+}
+
+func TestDisjointShortestPaths(t *testing.T) {
+	// The shortest path s-a-b-t blocks the second path, and the
+	// optimal pair avoids the edge a-b
+	g := graph.New[string](graph.KindUndirected)
+	g.AddWeightedEdge("s", "a", 1)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("b", "t", 1)
+	g.AddWeightedEdge("s", "c", 2)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("a", "d", 2)
+	g.AddWeightedEdge("d", "t", 2)
+
+	paths, weight, err := graph.DisjointShortestPaths(g, "s", "t", 2, graph.VertexDisjoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 || weight != 10 {
+		t.Fatalf("want 2 paths of total weight 10, got %v with weight %v", paths, weight)
+	}
+	for _, path := range paths {
+		if slices.Contains(path, "a") && slices.Contains(path, "b") {
+			t.Fatalf("want paths avoiding edge a-b, got %v", paths)
+		}
+	}
+
+	if _, _, err := graph.DisjointShortestPaths(g, "s", "t", 3, graph.EdgeDisjoint); !errors.Is(err, graph.ErrNotEnoughDisjointPaths) {
+		t.Fatalf("want ErrNotEnoughDisjointPaths, got %v", err)
+	}
+	if _, _, err := graph.DisjointShortestPaths(g, "s", "s", 1, graph.EdgeDisjoint); err == nil {
+		t.Fatal("want error for equal source and destination")
+	}
+	for _, k := range []int{0, -1} {
+		if _, _, err := graph.DisjointShortestPaths(g, "s", "t", k, graph.EdgeDisjoint); err == nil {
+			t.Fatalf("want error for %d paths", k)
+		}
+	}
+}
+
+func TestDisjointShortestPathsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(45))
+	for iter := 0; iter < 40; iter++ {
+		var g graph.Graph[int]
+		if iter%2 == 0 {
+			g = newRandomDirectedGraph(r, 7, 0.4, false)
+		} else {
+			g = newRandomUndirectedGraph(r, 7, 0.45)
+		}
+
+		for _, mode := range []graph.DisjointMode{graph.EdgeDisjoint, graph.VertexDisjoint} {
+			want := bruteForceDisjointPair(t, g, 0, 6, mode)
+			paths, weight, err := graph.DisjointShortestPaths(g, 0, 6, 2, mode)
+			if math.IsInf(want, 1) {
+				if !errors.Is(err, graph.ErrNotEnoughDisjointPaths) {
+					t.Fatalf("want ErrNotEnoughDisjointPaths, got %v", err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifyDisjointPaths(t, g, paths, 0, 6, mode, weight)
+			if weight != want {
+				t.Fatalf("want total weight %v, got %v", want, weight)
+			}
+		}
+	}
+}
+
+func TestLocalConnectivity(t *testing.T) {
+	// Two triangles sharing vertex c
+	g := graph.New[string](graph.KindUndirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	g.AddEdge("e", "c")
+
+	if got, err := graph.LocalEdgeConnectivity(g, "a", "e"); err != nil || got != 2 {
+		t.Fatalf("want edge connectivity 2, got %v (%v)", got, err)
+	}
+	if got, err := graph.LocalVertexConnectivity(g, "a", "e"); err != nil || got != 1 {
+		t.Fatalf("want vertex connectivity 1, got %v (%v)", got, err)
+	}
+	if got, err := graph.LocalVertexConnectivity(g, "a", "b"); err != nil || got != 2 {
+		t.Fatalf("want vertex connectivity 2 for adjacent vertices, got %v (%v)", got, err)
+	}
+
+	r := rand.New(rand.NewSource(45))
+	for iter := 0; iter < 20; iter++ {
+		var g graph.Graph[int]
+		if iter%2 == 0 {
+			g = newRandomDirectedGraph(r, 6, 0.4, false)
+		} else {
+			g = newRandomUndirectedGraph(r, 7, 0.4)
+		}
+
+		got, err := graph.LocalEdgeConnectivity(g, 0, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteForceConnectivity(g, 0, 5, graph.EdgeDisjoint); got != want {
+			t.Fatalf("want edge connectivity %d, got %d", want, got)
+		}
+
+		if g.EdgeExists(0, 5) {
+			g.DeleteEdge(0, 5)
+		}
+		got, err = graph.LocalVertexConnectivity(g, 0, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteForceConnectivity(g, 0, 5, graph.VertexDisjoint); got != want {
+			t.Fatalf("want vertex connectivity %d, got %d", want, got)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+// disjointSet implements a disjoint-set (union-find) data structure
+// over the integers 0 .. n-1, using path compression and union by
+// size.
+type disjointSet struct {
+	parent []int
+	size   []int
+}
+
+// newDisjointSet creates a new disjoint set, where each element
+// belongs to its own set
+func newDisjointSet(n int) *disjointSet {
+	ds := &disjointSet{
+		parent: make([]int, n),
+		size:   make([]int, n),
+	}
+	for i := 0; i < n; i++ {
+		ds.parent[i] = i
+		ds.size[i] = 1
+	}
+
+	return ds
+}
+
+// find returns the representative element of the set containing X
+func (ds *disjointSet) find(x int) int {
+	for ds.parent[x] != x {
+		ds.parent[x] = ds.parent[ds.parent[x]]
+		x = ds.parent[x]
+	}
+
+	return x
+}
+
+// union merges the sets containing X and Y. Returns false, if both
+// elements already belong to the same set.
+func (ds *disjointSet) union(x, y int) bool {
+	rx, ry := ds.find(x), ds.find(y)
+	if rx == ry {
+		return false
+	}
+
+	if ds.size[rx] < ds.size[ry] {
+		rx, ry = ry, rx
+	}
+	ds.parent[ry] = rx
+	ds.size[rx] += ds.size[ry]
+
+	return true
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+// immediateDominators computes the immediate dominator of each vertex
+// reachable from the entry vertex, using the iterative algorithm of
+// Cooper, Harvey and Kennedy over a post-order DFS traversal. Returns
+// the reachable vertices in post-order, along with the position of
+// the immediate dominator of each one. The entry vertex is the last
+// one, and is its own immediate dominator.
+func immediateDominators[T comparable](g Graph[T], entry T) ([]T, []int, error) {
+	if g.Kind() != KindDirected {
+		return nil, nil, ErrIsNotDirectedGraph
+	}
+
+	order := make([]T, 0)
+	walkFunc := func(v *Vertex[T]) error {
+		order = append(order, v.Value)
+		return nil
+	}
+	if err := WalkPostOrderDFS(g, entry, walkFunc); err != nil {
+		return nil, nil, err
+	}
+
+	position := make(map[T]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	// Predecessors of each reachable vertex
+	preds := make([][]int, len(order))
+	for _, e := range g.GetEdges() {
+		from, ok := position[e.From]
+		if !ok {
+			continue
+		}
+		to := position[e.To]
+		preds[to] = append(preds[to], from)
+	}
+
+	// Each vertex is visited after its parent in the DFS tree, so
+	// the dominators of a vertex come later in the post-order. The
+	// intersection walks up the dominator tree using that order.
+	idom := make([]int, len(order))
+	for i := range idom {
+		idom[i] = -1
+	}
+	root := len(order) - 1
+	idom[root] = root
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for a < b {
+				a = idom[a]
+			}
+			for b < a {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for v := root - 1; v >= 0; v-- {
+			newIdom := -1
+			for _, p := range preds[v] {
+				if idom[p] == -1 {
+					continue
+				}
+				if newIdom == -1 {
+					newIdom = p
+				} else {
+					newIdom = intersect(p, newIdom)
+				}
+			}
+			if newIdom != idom[v] {
+				idom[v] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	return order, idom, nil
+}
+
+// dominatorTree converts the result of immediateDominators into a map
+// of immediate dominators and a dominator tree
+func dominatorTree[T comparable](order []T, idom []int) (map[T]T, Graph[T]) {
+	result := make(map[T]T, len(order))
+	tree := New[T](KindDirected)
+	root := len(order) - 1
+	tree.AddVertex(order[root])
+	for v := root - 1; v >= 0; v-- {
+		result[order[v]] = order[idom[v]]
+		tree.AddEdge(order[idom[v]], order[v])
+	}
+
+	return result, tree
+}
+
+// Dominators computes the immediate dominators of the vertices in a
+// directed graph, which are reachable from the given entry vertex. A
+// vertex D dominates a vertex V, if each path from the entry to V
+// passes through D. The immediate dominator of V is the closest of
+// its dominators other than V itself.
+//
+// Returns a map of each reachable vertex other than the entry to its
+// immediate dominator, and the dominator tree, which contains an edge
+// from each immediate dominator to the vertices it dominates.
+func Dominators[T comparable](g Graph[T], entry T) (map[T]T, Graph[T], error) {
+	order, idom, err := immediateDominators(g, entry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, tree := dominatorTree(order, idom)
+
+	return result, tree, nil
+}
+
+// PostDominators computes the immediate post-dominators of the
+// vertices in a directed graph, from which the given exit vertex is
+// reachable. A vertex D post-dominates a vertex V, if each path from
+// V to the exit passes through D.
+//
+// Returns a map of each vertex other than the exit to its immediate
+// post-dominator, and the post-dominator tree, which contains an edge
+// from each immediate post-dominator to the vertices it
+// post-dominates.
+func PostDominators[T comparable](g Graph[T], exit T) (map[T]T, Graph[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, nil, ErrIsNotDirectedGraph
+	}
+
+	return Dominators(reversedGraph(g), exit)
+}
+
+// DominanceFrontiers computes the dominance frontier of each vertex
+// in a directed graph, which is reachable from the given entry
+// vertex. The dominance frontier of a vertex D contains the vertices
+// V, such that D dominates a predecessor of V, but does not strictly
+// dominate V itself.
+func DominanceFrontiers[T comparable](g Graph[T], entry T) (map[T][]T, error) {
+	order, idom, err := immediateDominators(g, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	position := make(map[T]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+
+	frontiers := make([][]int, len(order))
+	seen := make([]map[int]bool, len(order))
+	for v := range seen {
+		seen[v] = make(map[int]bool)
+	}
+
+	preds := make([][]int, len(order))
+	for _, e := range g.GetEdges() {
+		if from, ok := position[e.From]; ok {
+			preds[position[e.To]] = append(preds[position[e.To]], from)
+		}
+	}
+
+	// Walk up the dominator tree from the predecessors of each
+	// join vertex, until reaching its immediate dominator. The
+	// entry has no immediate dominator, so the walk for it
+	// continues up to the root of the tree.
+	root := len(order) - 1
+	for v := root; v >= 0; v-- {
+		stop := idom[v]
+		if v == root {
+			stop = -1
+		} else if len(preds[v]) < 2 {
+			continue
+		}
+		for _, p := range preds[v] {
+			for runner := p; runner != stop; runner = idom[runner] {
+				if !seen[runner][v] {
+					seen[runner][v] = true
+					frontiers[runner] = append(frontiers[runner], v)
+				}
+				if runner == root {
+					break
+				}
+			}
+		}
+	}
+
+	result := make(map[T][]T, len(order))
+	for v, frontier := range frontiers {
+		values := make([]T, 0, len(frontier))
+		for _, u := range frontier {
+			values = append(values, order[u])
+		}
+		result[order[v]] = values
+	}
+
+	return result, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns the vertices reachable from the
+// source without passing through the given vertex
+func reachableAvoiding(g graph.Graph[int], source, avoid int) map[int]bool {
+	seen := map[int]bool{}
+	if source == avoid {
+		return seen
+	}
+
+	seen[source] = true
+	stack := []int{source}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, u := range g.GetNeighbours(v) {
+			if u != avoid && !seen[u] {
+				seen[u] = true
+				stack = append(stack, u)
+			}
+		}
+	}
+
+	return seen
+}
+
+// A helper function which returns the strict dominators of each
+// vertex reachable from the entry, by removing each vertex in turn
+func bruteForceDominators(g graph.Graph[int], entry int) map[int]map[int]bool {
+	reachable := reachableAvoiding(g, entry, -1)
+	doms := make(map[int]map[int]bool)
+	for v := range reachable {
+		doms[v] = make(map[int]bool)
+	}
+	for d := range reachable {
+		remaining := reachableAvoiding(g, entry, d)
+		for v := range reachable {
+			if v != d && !remaining[v] {
+				doms[v][d] = true
+			}
+		}
+	}
+
+	return doms
+}
+
+func TestDominators(t *testing.T) {
+	// Control-flow graph of a loop with an if-else in the body
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("entry", "loop")
+	g.AddEdge("loop", "then")
+	g.AddEdge("loop", "else")
+	g.AddEdge("then", "join")
+	g.AddEdge("else", "join")
+	g.AddEdge("join", "loop")
+	g.AddEdge("loop", "exit")
+	g.AddVertex("dead")
+
+	idom, tree, err := graph.Dominators(g, "entry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"loop": "entry",
+		"then": "loop",
+		"else": "loop",
+		"join": "loop",
+		"exit": "loop",
+	}
+	if len(idom) != len(want) {
+		t.Fatalf("want %d immediate dominators, got %d", len(want), len(idom))
+	}
+	for v, d := range want {
+		if idom[v] != d {
+			t.Fatalf("want immediate dominator %v for %v, got %v", d, v, idom[v])
+		}
+		if !tree.EdgeExists(d, v) {
+			t.Fatalf("want edge %v-%v in the dominator tree", d, v)
+		}
+	}
+	if len(tree.GetVertices()) != 6 || tree.VertexExists("dead") {
+		t.Fatalf("want 6 reachable vertices in the tree, got %v", tree.GetVertexValues())
+	}
+
+	frontiers, err := graph.DominanceFrontiers(g, "entry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFrontiers := map[string][]string{
+		"entry": {},
+		"loop":  {"loop"},
+		"then":  {"join"},
+		"else":  {"join"},
+		"join":  {"loop"},
+		"exit":  {},
+	}
+	for v, frontier := range wantFrontiers {
+		if !slices.Equal(frontiers[v], frontier) {
+			t.Fatalf("want dominance frontier %v for %v, got %v", frontier, v, frontiers[v])
+		}
+	}
+
+	ipdom, _, err := graph.PostDominators(g, "exit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPost := map[string]string{
+		"entry": "loop",
+		"loop":  "exit",
+		"then":  "join",
+		"else":  "join",
+		"join":  "loop",
+	}
+	if len(ipdom) != len(wantPost) {
+		t.Fatalf("want %d immediate post-dominators, got %d", len(wantPost), len(ipdom))
+	}
+	for v, d := range wantPost {
+		if ipdom[v] != d {
+			t.Fatalf("want immediate post-dominator %v for %v, got %v", d, v, ipdom[v])
+		}
+	}
+
+	if _, _, err := graph.Dominators(g, "missing"); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+	if _, _, err := graph.Dominators(graph.New[int](graph.KindUndirected), 1); !errors.Is(err, graph.ErrIsNotDirectedGraph) {
+		t.Fatalf("want ErrIsNotDirectedGraph, got %v", err)
+	}
+}
+
+func TestDominatorsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(39))
+	for iter := 0; iter < 50; iter++ {
+		g := newRandomDirectedGraph(r, 10, 0.2, false)
+		doms := bruteForceDominators(g, 0)
+
+		idom, _, err := graph.Dominators(g, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(idom) != len(doms)-1 {
+			t.Fatalf("want %d immediate dominators, got %d", len(doms)-1, len(idom))
+		}
+
+		// The immediate dominator is the strict dominator,
+		// which is dominated by all other strict dominators
+		for v, d := range idom {
+			if !doms[v][d] {
+				t.Fatalf("%v does not dominate %v", d, v)
+			}
+			for other := range doms[v] {
+				if other != d && !doms[d][other] {
+					t.Fatalf("want immediate dominator of %v closer than %v", v, d)
+				}
+			}
+		}
+
+		// Dominance frontiers by definition
+		frontiers, err := graph.DominanceFrontiers(g, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for x := range doms {
+			want := make([]int, 0)
+			for y := range doms {
+				if doms[y][x] {
+					continue
+				}
+				for _, e := range g.GetEdges() {
+					if e.To == y && doms[e.From] != nil && (e.From == x || doms[e.From][x]) {
+						want = append(want, y)
+						break
+					}
+				}
+			}
+			got := slices.Clone(frontiers[x])
+			slices.Sort(want)
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				t.Fatalf("want dominance frontier %v for %v, got %v", want, x, got)
+			}
+		}
+	}
+}
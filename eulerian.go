@@ -0,0 +1,233 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrNoEulerianCircuit is returned whenever the graph does not
+// contain an Eulerian circuit.
+var ErrNoEulerianCircuit = errors.New("no eulerian circuit exists")
+
+// ErrNoEulerianPath is returned whenever the graph does not contain
+// an Eulerian path.
+var ErrNoEulerianPath = errors.New("no eulerian path exists")
+
+// checkEdgesConnected returns an error, if the vertices with non-zero
+// degree do not belong to the same (weakly) connected component.
+func checkEdgesConnected[T comparable](g Graph[T], idx *vertexIndex[T], sentinel error) error {
+	ds := newDisjointSet(len(idx.values))
+	for _, e := range g.GetEdges() {
+		ds.union(idx.index[e.From], idx.index[e.To])
+	}
+
+	root := -1
+	var rootValue T
+	for _, e := range g.GetEdges() {
+		r := ds.find(idx.index[e.From])
+		if root == -1 {
+			root = r
+			rootValue = e.From
+			continue
+		}
+		if r != root {
+			return fmt.Errorf("%w: edges of vertices %v and %v are not connected", sentinel, rootValue, e.From)
+		}
+	}
+
+	return nil
+}
+
+// hierholzer finds an Eulerian trail starting from the given vertex
+// position using Hierholzer's algorithm. The edges are given as pairs
+// of vertex positions, which allows for parallel edges. Returns the
+// vertex positions along the trail, which contains all edges
+// reachable from the start.
+func hierholzer(n int, edges [][2]int, directed bool, start int) []int {
+	// Edges are identified by their position in the list of
+	// edges, so that both arcs of an undirected edge share it.
+	type arc struct {
+		to   int
+		edge int
+	}
+	arcs := make([][]arc, n)
+	for k, e := range edges {
+		from, to := e[0], e[1]
+		arcs[from] = append(arcs[from], arc{to: to, edge: k})
+		if !directed && from != to {
+			arcs[to] = append(arcs[to], arc{to: from, edge: k})
+		}
+	}
+
+	used := make([]bool, len(edges))
+	next := make([]int, n)
+	stack := []int{start}
+	trail := make([]int, 0, len(edges)+1)
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+
+		// Skip over the arcs of edges we've already used
+		for next[v] < len(arcs[v]) && used[arcs[v][next[v]].edge] {
+			next[v]++
+		}
+
+		if next[v] == len(arcs[v]) {
+			// No more unused edges, backtrack
+			stack = stack[:len(stack)-1]
+			trail = append(trail, v)
+			continue
+		}
+
+		a := arcs[v][next[v]]
+		used[a.edge] = true
+		stack = append(stack, a.to)
+	}
+
+	slices.Reverse(trail)
+
+	return trail
+}
+
+// trailValues converts the vertex positions of a trail into values
+func trailValues[T comparable](idx *vertexIndex[T], trail []int) []T {
+	result := make([]T, 0, len(trail))
+	for _, v := range trail {
+		result = append(result, idx.values[v])
+	}
+
+	return result
+}
+
+// EulerianCircuit returns an Eulerian circuit of the graph, starting
+// and ending at the given vertex. An Eulerian circuit traverses each
+// edge of the graph exactly once. The result contains the vertices
+// along the circuit, where the first and the last one are the start
+// vertex.
+//
+// A circuit exists, if each vertex of an undirected graph has an even
+// degree, or each vertex of a directed graph has equal in- and
+// out-degree, and all edges belong to the same connected component.
+// Otherwise, an error wrapping ErrNoEulerianCircuit is returned.
+func EulerianCircuit[T comparable](g Graph[T], start T) ([]T, error) {
+	if !g.VertexExists(start) {
+		return nil, fmt.Errorf("Source vertex %v not found in the graph", start)
+	}
+
+	for _, v := range g.GetVertices() {
+		if g.Kind() == KindUndirected && v.Degree.Out%2 != 0 {
+			return nil, fmt.Errorf("%w: vertex %v has odd degree %d", ErrNoEulerianCircuit, v.Value, v.Degree.Out)
+		}
+		if g.Kind() == KindDirected && v.Degree.In != v.Degree.Out {
+			return nil, fmt.Errorf("%w: vertex %v has in-degree %d and out-degree %d", ErrNoEulerianCircuit, v.Value, v.Degree.In, v.Degree.Out)
+		}
+	}
+
+	if len(g.GetEdges()) > 0 && g.GetVertex(start).Degree.Out == 0 {
+		return nil, fmt.Errorf("%w: start vertex %v has no edges", ErrNoEulerianCircuit, start)
+	}
+
+	idx := newVertexIndex(g)
+	if err := checkEdgesConnected(g, idx, ErrNoEulerianCircuit); err != nil {
+		return nil, err
+	}
+
+	trail := hierholzer(len(idx.values), idx.edges(g), g.Kind() == KindDirected, idx.index[start])
+
+	return trailValues(idx, trail), nil
+}
+
+// EulerianPath returns an Eulerian path of the graph, which traverses
+// each edge of the graph exactly once. The result contains the
+// vertices along the path. If the graph contains an Eulerian circuit,
+// then the path is a circuit.
+//
+// A path exists, if all edges belong to the same connected component,
+// and either zero or two vertices of an undirected graph have an odd
+// degree, or in a directed graph at most one vertex has one more
+// outgoing than incoming edges, at most one vertex has one more
+// incoming than outgoing edges, and all other vertices have equal
+// in- and out-degree. Otherwise, an error wrapping ErrNoEulerianPath
+// is returned.
+func EulerianPath[T comparable](g Graph[T]) ([]T, error) {
+	if len(g.GetEdges()) == 0 {
+		return []T{}, nil
+	}
+
+	var start *Vertex[T]
+	if g.Kind() == KindUndirected {
+		odd := make([]*Vertex[T], 0)
+		for _, v := range g.GetVertices() {
+			if v.Degree.Out%2 != 0 {
+				odd = append(odd, v)
+			}
+			if start == nil && v.Degree.Out > 0 {
+				start = v
+			}
+		}
+		if len(odd) != 0 && len(odd) != 2 {
+			return nil, fmt.Errorf("%w: graph has %d vertices with odd degree", ErrNoEulerianPath, len(odd))
+		}
+		if len(odd) == 2 {
+			start = odd[0]
+		}
+	} else {
+		starts := 0
+		ends := 0
+		for _, v := range g.GetVertices() {
+			switch v.Degree.Out - v.Degree.In {
+			case 0:
+				if start == nil && v.Degree.Out > 0 {
+					start = v
+				}
+			case 1:
+				// The path must start from the vertex
+				// with the surplus outgoing edge
+				starts++
+				start = v
+			case -1:
+				ends++
+			default:
+				return nil, fmt.Errorf("%w: vertex %v has in-degree %d and out-degree %d", ErrNoEulerianPath, v.Value, v.Degree.In, v.Degree.Out)
+			}
+		}
+		if starts > 1 || ends > 1 {
+			return nil, fmt.Errorf("%w: graph has %d vertices with surplus outgoing and %d with surplus incoming edges", ErrNoEulerianPath, starts, ends)
+		}
+	}
+
+	idx := newVertexIndex(g)
+	if err := checkEdgesConnected(g, idx, ErrNoEulerianPath); err != nil {
+		return nil, err
+	}
+
+	trail := hierholzer(len(idx.values), idx.edges(g), g.Kind() == KindDirected, idx.index[start.Value])
+
+	return trailValues(idx, trail), nil
+}
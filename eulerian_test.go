@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the given trail traverses
+// each edge of the graph exactly once
+func verifyEulerianTrail[T comparable](t *testing.T, g graph.Graph[T], trail []T) {
+	edges := g.GetEdges()
+	if len(trail) != len(edges)+1 {
+		t.Fatalf("want trail of %d vertices, got %d", len(edges)+1, len(trail))
+	}
+
+	used := make(map[*graph.Edge[T]]bool)
+	for i := 0; i+1 < len(trail); i++ {
+		e := g.GetEdge(trail[i], trail[i+1])
+		if e == nil {
+			t.Fatalf("no edge between %v and %v", trail[i], trail[i+1])
+		}
+		if used[e] {
+			t.Fatalf("edge %v-%v traversed more than once", e.From, e.To)
+		}
+		used[e] = true
+	}
+}
+
+func TestEulerianCircuit(t *testing.T) {
+	// Two triangles sharing a vertex
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 3)
+	g.AddEdge(1, 1)
+
+	circuit, err := graph.EulerianCircuit(g, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyEulerianTrail(t, g, circuit)
+	if circuit[0] != 4 || circuit[len(circuit)-1] != 4 {
+		t.Fatalf("circuit must start and end at 4, got %v", circuit)
+	}
+
+	// De Bruijn sequence B(2, 3) as a circuit over the 2-bit
+	// words, where each edge represents a 3-bit word
+	d := graph.New[string](graph.KindDirected)
+	for i := 0; i < 8; i++ {
+		word := fmt.Sprintf("%03b", i)
+		d.AddEdge(word[:2], word[1:])
+	}
+	words, err := graph.EulerianCircuit(d, "00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyEulerianTrail(t, d, words)
+	sequence := ""
+	for _, v := range words[1:] {
+		sequence += v[1:]
+	}
+	seen := make(map[string]bool)
+	cyclic := sequence + sequence[:2]
+	for i := 0; i < len(sequence); i++ {
+		seen[cyclic[i:i+3]] = true
+	}
+	if len(seen) != 8 {
+		t.Fatalf("de Bruijn sequence %s must contain all 8 words", sequence)
+	}
+
+	// Graph without edges
+	empty := graph.New[int](graph.KindUndirected)
+	empty.AddVertex(1)
+	circuit, err = graph.EulerianCircuit(empty, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(circuit, []int{1}) {
+		t.Fatalf("want circuit [1], got %v", circuit)
+	}
+
+	// Odd degree vertices
+	if _, err := graph.EulerianCircuit(newPathGraph(), 1); !errors.Is(err, graph.ErrNoEulerianCircuit) {
+		t.Fatalf("EulerianCircuit: should fail with odd degree vertices, got %v", err)
+	}
+
+	// Unbalanced directed graph
+	if _, err := graph.EulerianCircuit(newDirectedGraph(), 1); !errors.Is(err, graph.ErrNoEulerianCircuit) {
+		t.Fatalf("EulerianCircuit: should fail with unbalanced vertices, got %v", err)
+	}
+
+	// Disconnected edges
+	disconnected := graph.New[int](graph.KindUndirected)
+	disconnected.AddEdge(1, 2)
+	disconnected.AddEdge(2, 3)
+	disconnected.AddEdge(3, 1)
+	disconnected.AddEdge(4, 5)
+	disconnected.AddEdge(5, 6)
+	disconnected.AddEdge(6, 4)
+	if _, err := graph.EulerianCircuit(disconnected, 1); !errors.Is(err, graph.ErrNoEulerianCircuit) {
+		t.Fatalf("EulerianCircuit: should fail with disconnected edges, got %v", err)
+	}
+
+	// Start vertex without edges
+	disconnected.AddVertex(7)
+	if _, err := graph.EulerianCircuit(disconnected, 7); !errors.Is(err, graph.ErrNoEulerianCircuit) {
+		t.Fatalf("EulerianCircuit: should fail with isolated start vertex, got %v", err)
+	}
+
+	// Unknown start vertex
+	if _, err := graph.EulerianCircuit(disconnected, 42); err == nil {
+		t.Fatal("EulerianCircuit: should fail with unknown start vertex")
+	}
+}
+
+func TestEulerianPath(t *testing.T) {
+	// House graph, where the path must start and end at the
+	// vertices with odd degree
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+	g.AddEdge(3, 5)
+	g.AddEdge(5, 4)
+
+	path, err := graph.EulerianPath(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyEulerianTrail(t, g, path)
+	ends := []int{path[0], path[len(path)-1]}
+	slices.Sort(ends)
+	if !slices.Equal(ends, []int{3, 4}) {
+		t.Fatalf("path must start and end at 3 and 4, got %v", path)
+	}
+
+	// Directed path
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	d.AddEdge(3, 1)
+	d.AddEdge(1, 4)
+	path, err = graph.EulerianPath(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyEulerianTrail(t, d, path)
+	if path[0] != 1 || path[len(path)-1] != 4 {
+		t.Fatalf("path must start at 1 and end at 4, got %v", path)
+	}
+
+	// Circuits are paths as well
+	cycle := graph.New[int](graph.KindDirected)
+	cycle.AddEdge(1, 2)
+	cycle.AddEdge(2, 3)
+	cycle.AddEdge(3, 1)
+	path, err = graph.EulerianPath(cycle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyEulerianTrail(t, cycle, path)
+
+	// Too many vertices with odd degree
+	star := graph.New[int](graph.KindUndirected)
+	star.AddEdge(0, 1)
+	star.AddEdge(0, 2)
+	star.AddEdge(0, 3)
+	if _, err := graph.EulerianPath(star); !errors.Is(err, graph.ErrNoEulerianPath) {
+		t.Fatalf("EulerianPath: should fail with odd degree vertices, got %v", err)
+	}
+
+	// Too many vertices with surplus outgoing edges
+	if _, err := graph.EulerianPath(newDirectedGraph()); !errors.Is(err, graph.ErrNoEulerianPath) {
+		t.Fatalf("EulerianPath: should fail with unbalanced vertices, got %v", err)
+	}
+
+	// Disconnected edges
+	disconnected := graph.New[int](graph.KindDirected)
+	disconnected.AddEdge(1, 2)
+	disconnected.AddEdge(3, 4)
+	disconnected.AddEdge(4, 3)
+	if _, err := graph.EulerianPath(disconnected); !errors.Is(err, graph.ErrNoEulerianPath) {
+		t.Fatalf("EulerianPath: should fail with disconnected edges, got %v", err)
+	}
+
+	// Graph without edges
+	path, err = graph.EulerianPath(graph.New[int](graph.KindDirected))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(path) != 0 {
+		t.Fatalf("want empty path, got %v", path)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import "math/bits"
+
+// MaxExactFeedbackArcSetVertices is the maximum number of vertices in
+// a strongly connected component, for which FeedbackArcSet finds a
+// minimum feedback arc set.
+const MaxExactFeedbackArcSetVertices = 16
+
+// eadesLinSmythOrder orders the vertices using the heuristic of
+// Eades, Lin and Smyth. Sinks are moved to the end and sources to the
+// start of the order, and otherwise the vertex with the largest
+// difference between its out- and in-degree is moved to the start.
+// The arcs must not contain self-loops.
+func eadesLinSmythOrder(vertices []int, out, in [][]int) []int {
+	removed := make(map[int]bool, len(vertices))
+	outDegree := make(map[int]int, len(vertices))
+	inDegree := make(map[int]int, len(vertices))
+	for _, v := range vertices {
+		outDegree[v] = len(out[v])
+		inDegree[v] = len(in[v])
+	}
+
+	remove := func(v int) {
+		removed[v] = true
+		for _, u := range out[v] {
+			inDegree[u]--
+		}
+		for _, u := range in[v] {
+			outDegree[u]--
+		}
+	}
+
+	left := make([]int, 0, len(vertices))
+	right := make([]int, 0)
+	for len(left)+len(right) < len(vertices) {
+		progress := true
+		for progress {
+			progress = false
+			for _, v := range vertices {
+				if removed[v] {
+					continue
+				}
+				if outDegree[v] == 0 {
+					right = append(right, v)
+					remove(v)
+					progress = true
+				} else if inDegree[v] == 0 {
+					left = append(left, v)
+					remove(v)
+					progress = true
+				}
+			}
+		}
+
+		best := -1
+		for _, v := range vertices {
+			if removed[v] {
+				continue
+			}
+			if best == -1 || outDegree[v]-inDegree[v] > outDegree[best]-inDegree[best] {
+				best = v
+			}
+		}
+		if best != -1 {
+			left = append(left, best)
+			remove(best)
+		}
+	}
+
+	// Sinks have been collected in reverse order
+	for i := len(right) - 1; i >= 0; i-- {
+		left = append(left, right[i])
+	}
+
+	return left
+}
+
+// exactFeedbackOrder orders the vertices, so that the number of arcs
+// leading backwards is minimal, using dynamic programming over the
+// subsets of vertices. The arcs must not contain self-loops.
+func exactFeedbackOrder(vertices []int, out [][]int) []int {
+	n := len(vertices)
+	local := make(map[int]int, n)
+	for i, v := range vertices {
+		local[v] = i
+	}
+	outMask := make([]int, n)
+	for i, v := range vertices {
+		for _, u := range out[v] {
+			outMask[i] |= 1 << local[u]
+		}
+	}
+
+	// cost[mask] is the minimum number of backward arcs among the
+	// orderings of the subset, and last[mask] is the vertex
+	// placed last in such an ordering
+	cost := make([]int, 1<<n)
+	last := make([]int, 1<<n)
+	for mask := 1; mask < 1<<n; mask++ {
+		cost[mask] = -1
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			prev := mask &^ (1 << i)
+			alt := cost[prev] + bits.OnesCount(uint(outMask[i]&prev))
+			if cost[mask] == -1 || alt < cost[mask] {
+				cost[mask] = alt
+				last[mask] = i
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for mask, k := 1<<n-1, n-1; mask != 0; k-- {
+		i := last[mask]
+		order[k] = vertices[i]
+		mask &^= 1 << i
+	}
+
+	return order
+}
+
+// FeedbackArcSet returns a set of edges, whose removal makes the
+// directed graph acyclic. Self-loops are always part of the set, and
+// edges between different strongly connected components never are.
+//
+// For strongly connected components with up to
+// MaxExactFeedbackArcSetVertices vertices, a minimum set of edges is
+// found using dynamic programming. Larger components are handled by
+// the heuristic of Eades, Lin and Smyth, which returns at most m/2 -
+// n/6 edges for a component with n vertices and m edges.
+func FeedbackArcSet[T comparable](g Graph[T]) ([]*Edge[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	comp, count := stronglyConnectedComponents(idx.adjacency(g))
+
+	// Arcs within the components, excluding self-loops
+	out := make([][]int, n)
+	in := make([][]int, n)
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from != to && comp[from] == comp[to] {
+			out[from] = append(out[from], to)
+			in[to] = append(in[to], from)
+		}
+	}
+
+	members := make([][]int, count)
+	for v, c := range comp {
+		members[c] = append(members[c], v)
+	}
+
+	position := make([]int, n)
+	for _, vertices := range members {
+		if len(vertices) == 1 {
+			continue
+		}
+
+		var order []int
+		if len(vertices) <= MaxExactFeedbackArcSetVertices {
+			order = exactFeedbackOrder(vertices, out)
+		} else {
+			order = eadesLinSmythOrder(vertices, out, in)
+		}
+		for i, v := range order {
+			position[v] = i
+		}
+	}
+
+	result := make([]*Edge[T], 0)
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if comp[from] == comp[to] && position[from] >= position[to] {
+			result = append(result, e)
+		}
+	}
+
+	return result, nil
+}
+
+// MakeAcyclic returns a copy of the directed graph, from which the
+// edges returned by FeedbackArcSet are removed, so that the result is
+// acyclic.
+func MakeAcyclic[T comparable](g Graph[T]) (Graph[T], error) {
+	feedback, err := FeedbackArcSet(g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := g.Clone()
+	for _, e := range feedback {
+		result.DeleteEdge(e.From, e.To)
+	}
+
+	return result, nil
+}
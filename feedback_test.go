@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns whether the edges, excluding the
+// ones in the given mask, form an acyclic graph, using Kahn's
+// algorithm
+func isAcyclicWithout(n int, edges []*graph.Edge[int], removed int) bool {
+	inDegree := make([]int, n)
+	out := make([][]int, n)
+	for i, e := range edges {
+		if removed&(1<<i) == 0 {
+			out[e.From] = append(out[e.From], e.To)
+			inDegree[e.To]++
+		}
+	}
+
+	queue := make([]int, 0)
+	for v := 0; v < n; v++ {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+	visited := 0
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, u := range out[v] {
+			inDegree[u]--
+			if inDegree[u] == 0 {
+				queue = append(queue, u)
+			}
+		}
+	}
+
+	return visited == n
+}
+
+// A helper function which verifies that removing the feedback arc
+// set results in an acyclic graph, and that MakeAcyclic returns an
+// acyclic copy of the graph
+func verifyFeedbackArcSet[T comparable](t *testing.T, g graph.Graph[T], feedback []*graph.Edge[T]) {
+	walkFunc := func(v *graph.Vertex[T]) error {
+		return nil
+	}
+
+	h := g.Clone()
+	for _, e := range feedback {
+		h.DeleteEdge(e.From, e.To)
+	}
+	if err := graph.WalkTopoOrder(h, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	dag, err := graph.MakeAcyclic(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := graph.WalkTopoOrder(dag, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range dag.GetEdges() {
+		if !g.EdgeExists(e.From, e.To) {
+			t.Fatalf("edge %v-%v is not in the graph", e.From, e.To)
+		}
+	}
+}
+
+func TestFeedbackArcSet(t *testing.T) {
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "c")
+	g.AddEdge("d", "e")
+	g.AddEdge("e", "e")
+
+	feedback, err := graph.FeedbackArcSet(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Removing c-a and c-d leaves a DAG, along with the self-loop
+	if len(feedback) != 3 {
+		t.Fatalf("want 3 edges, got %d", len(feedback))
+	}
+	for _, e := range feedback {
+		if e.From == "d" && e.To == "e" {
+			t.Fatal("edge d-e does not lie on a cycle")
+		}
+	}
+	verifyFeedbackArcSet(t, g, feedback)
+	if len(g.GetEdges()) != 7 {
+		t.Fatalf("want original graph unchanged, got %d edges", len(g.GetEdges()))
+	}
+
+	if _, err := graph.FeedbackArcSet(graph.New[int](graph.KindUndirected)); !errors.Is(err, graph.ErrIsNotDirectedGraph) {
+		t.Fatalf("want ErrIsNotDirectedGraph, got %v", err)
+	}
+}
+
+func TestFeedbackArcSetRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomDirectedGraph(r, 6, 0.4, false)
+		edges := g.GetEdges()
+		feedback, err := graph.FeedbackArcSet(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyFeedbackArcSet(t, g, feedback)
+
+		want := len(edges)
+		for mask := 0; mask < 1<<len(edges); mask++ {
+			if k := bits.OnesCount(uint(mask)); k < want && isAcyclicWithout(6, edges, mask) {
+				want = k
+			}
+		}
+		if len(feedback) != want {
+			t.Fatalf("want %d edges, got %d", want, len(feedback))
+		}
+	}
+
+	// Large components are handled by the heuristic
+	for iter := 0; iter < 5; iter++ {
+		g := newRandomDirectedGraph(r, 80, 0.08, false)
+		feedback, err := graph.FeedbackArcSet(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyFeedbackArcSet(t, g, feedback)
+		if len(feedback) > len(g.GetEdges())/2 {
+			t.Fatalf("want at most %d edges, got %d", len(g.GetEdges())/2, len(feedback))
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"math"
+	"slices"
+)
+
+// flowArc represents an arc of a flow network along with its residual
+// capacity. Each arc is stored next to its reverse arc, so that the
+// reverse of arc k is at position k^1.
+type flowArc struct {
+	to       int
+	capacity float64
+	cost     float64
+}
+
+// flowNetwork represents a flow network over vertex positions, which
+// supports parallel arcs and unbounded capacities.
+type flowNetwork struct {
+	arcs     []flowArc
+	incident [][]int
+}
+
+// newFlowNetwork creates a new flow network with n vertices
+func newFlowNetwork(n int) *flowNetwork {
+	return &flowNetwork{
+		arcs:     make([]flowArc, 0),
+		incident: make([][]int, n),
+	}
+}
+
+// addArc adds an arc with the given capacity and cost per unit of
+// flow to the network, and returns its identifier
+func (fn *flowNetwork) addArc(from, to int, capacity, cost float64) int {
+	k := len(fn.arcs)
+	fn.arcs = append(fn.arcs, flowArc{to: to, capacity: capacity, cost: cost})
+	fn.arcs = append(fn.arcs, flowArc{to: from, capacity: 0, cost: -cost})
+	fn.incident[from] = append(fn.incident[from], k)
+	fn.incident[to] = append(fn.incident[to], k+1)
+
+	return k
+}
+
+// flow returns the amount of flow along the arc with the given
+// identifier
+func (fn *flowNetwork) flow(k int) float64 {
+	return fn.arcs[k^1].capacity
+}
+
+// augment pushes the given amount of flow along the path of arcs
+func (fn *flowNetwork) augment(path []int, amount float64) {
+	for _, k := range path {
+		fn.arcs[k].capacity -= amount
+		fn.arcs[k^1].capacity += amount
+	}
+}
+
+// cheapestPath returns the arcs along a path of minimum cost from s
+// to t in the residual network, using the Bellman-Ford algorithm,
+// since the residual network contains arcs with negative costs.
+// Returns nil, if t is not reachable from s.
+func (fn *flowNetwork) cheapestPath(s, t int) []int {
+	n := len(fn.incident)
+	dist := make([]float64, n)
+	via := make([]int, n)
+	for v := range dist {
+		dist[v] = math.Inf(1)
+		via[v] = -1
+	}
+	dist[s] = 0
+
+	for i := 0; i < n; i++ {
+		changed := false
+		for v := 0; v < n; v++ {
+			if math.IsInf(dist[v], 1) {
+				continue
+			}
+			for _, k := range fn.incident[v] {
+				arc := fn.arcs[k]
+				if arc.capacity > 0 && dist[v]+arc.cost < dist[arc.to] {
+					dist[arc.to] = dist[v] + arc.cost
+					via[arc.to] = k
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	if math.IsInf(dist[t], 1) {
+		return nil
+	}
+
+	path := make([]int, 0)
+	for v := t; v != s; v = fn.arcs[via[v]^1].to {
+		path = append(path, via[v])
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+// minCostFlow sends up to the given amount of flow from s to t with
+// minimum total cost, by repeatedly augmenting along the cheapest
+// path in the residual network. The network must not contain cycles
+// of negative cost. Returns the amount of flow sent and its cost.
+func (fn *flowNetwork) minCostFlow(s, t int, amount float64) (float64, float64) {
+	flow, cost := 0.0, 0.0
+	for flow < amount {
+		path := fn.cheapestPath(s, t)
+		if path == nil {
+			break
+		}
+
+		bottleneck := amount - flow
+		for _, k := range path {
+			bottleneck = min(bottleneck, fn.arcs[k].capacity)
+		}
+		fn.augment(path, bottleneck)
+
+		flow += bottleneck
+		for _, k := range path {
+			cost += bottleneck * fn.arcs[k].cost
+		}
+	}
+
+	return flow, cost
+}
+
+// shortestAugmentingPath returns the arcs along a path from s to t
+// with the minimum number of arcs in the residual network. Returns
+// nil, if t is not reachable from s.
+func (fn *flowNetwork) shortestAugmentingPath(s, t int) []int {
+	via := make([]int, len(fn.incident))
+	for v := range via {
+		via[v] = -1
+	}
+
+	visited := make([]bool, len(fn.incident))
+	visited[s] = true
+	queue := []int{s}
+	for len(queue) > 0 && !visited[t] {
+		v := queue[0]
+		queue = queue[1:]
+		for _, k := range fn.incident[v] {
+			arc := fn.arcs[k]
+			if arc.capacity > 0 && !visited[arc.to] {
+				visited[arc.to] = true
+				via[arc.to] = k
+				queue = append(queue, arc.to)
+			}
+		}
+	}
+
+	if !visited[t] {
+		return nil
+	}
+
+	path := make([]int, 0)
+	for v := t; v != s; v = fn.arcs[via[v]^1].to {
+		path = append(path, via[v])
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+// maxFlow sends the maximum amount of flow from s to t using the
+// algorithm of Edmonds and Karp, and returns the amount of flow sent
+func (fn *flowNetwork) maxFlow(s, t int) float64 {
+	flow := 0.0
+	for {
+		path := fn.shortestAugmentingPath(s, t)
+		if path == nil {
+			return flow
+		}
+
+		bottleneck := math.Inf(1)
+		for _, k := range path {
+			bottleneck = min(bottleneck, fn.arcs[k].capacity)
+		}
+		fn.augment(path, bottleneck)
+		flow += bottleneck
+	}
+}
+
+// residualReachable returns the vertices, which are reachable from s
+// in the residual network. After computing a maximum flow they form
+// the source side of a minimum cut.
+func (fn *flowNetwork) residualReachable(s int) []bool {
+	visited := make([]bool, len(fn.incident))
+	visited[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, k := range fn.incident[v] {
+			arc := fn.arcs[k]
+			if arc.capacity > 0 && !visited[arc.to] {
+				visited[arc.to] = true
+				stack = append(stack, arc.to)
+			}
+		}
+	}
+
+	return visited
+}
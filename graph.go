@@ -27,7 +27,9 @@ package graph
 
 import (
 	"errors"
+	"fmt"
 	"slices"
+	"strings"
 )
 
 // Color represents the color with which a vertex is painted
@@ -89,6 +91,11 @@ type Vertex[T comparable] struct {
 	// graph.
 	Parent *Vertex[T]
 
+	// NearestSource represents the source vertex closest to this
+	// vertex, which is calculated when walking the graph from
+	// multiple source vertices.
+	NearestSource *Vertex[T]
+
 	// DotAttributes represents the list of attributes associated
 	// with the vertex. The attributes will be used when
 	// generating the Dot representation of the graph.
@@ -105,6 +112,7 @@ func NewVertex[T comparable](value T) *Vertex[T] {
 		Color:              White,
 		DistanceFromSource: 0.0,
 		Parent:             nil,
+		NearestSource:      nil,
 		DotAttributes:      make(DotAttributes),
 		Degree:             Degree{In: 0, Out: 0},
 	}
@@ -149,6 +157,42 @@ type WalkFunc[T comparable] func(v *Vertex[T]) error
 // walking of the graph should be stopped.
 var ErrStopWalking = errors.New("walking stopped")
 
+// WalkOptions represents the options used to bound the traversal of
+// a graph.
+type WalkOptions struct {
+	// MaxDepth is the maximum distance in edges from the source
+	// vertex, which is used by the breadth-first and depth-first
+	// traversals. Neighbours of vertices at this depth are not
+	// explored, so a depth of zero walks the source vertex only.
+	// When negative, the depth is not limited.
+	MaxDepth int
+
+	// MaxCost is the maximum shortest-path distance from the
+	// source vertex, which is used by Dijkstra's algorithm.
+	// Vertices farther than this are not walked, so a cost of zero
+	// walks the vertices reachable over edges of zero weight only.
+	// When negative, the cost is not limited.
+	MaxCost float64
+}
+
+// DefaultWalkOptions returns the default options for traversing a
+// graph, which do not bound the traversal.
+func DefaultWalkOptions() *WalkOptions {
+	opts := &WalkOptions{
+		MaxDepth: -1,
+		MaxCost:  -1,
+	}
+
+	return opts
+}
+
+// canExplore returns a boolean indicating whether the neighbours of
+// a vertex at the given depth may be explored, when walking the graph
+// with the given options.
+func (opts *WalkOptions) canExplore(depth float64) bool {
+	return opts.MaxDepth < 0 || depth < float64(opts.MaxDepth)
+}
+
 // Collector provides an easy way to collect vertices while walking a
 // graph
 type Collector[T comparable] struct {
@@ -236,6 +280,10 @@ type Graph[T comparable] interface {
 	// vertices
 	GetNeighbourVertices(v T) []*Vertex[T]
 
+	// IsNeighbour is a predicate for testing whether U is a direct
+	// neighbour of V
+	IsNeighbour(v, u T) bool
+
 	// ResetVertexAttributes resets the attributes for all
 	// vertices in the graph
 	ResetVertexAttributes()
@@ -258,6 +306,10 @@ type UndirectedGraph[T comparable] struct {
 	// The adjacency lists for our vertices
 	adjacencyLists map[T][]T
 
+	// The adjacency sets for our vertices, which provide fast
+	// testing for neighbour membership
+	adjacencySets map[T]map[T]bool
+
 	// The kind of the graph
 	kind GraphKind
 }
@@ -268,6 +320,7 @@ func New[T comparable](kind GraphKind) Graph[T] {
 		vertices:       make(map[T]*Vertex[T]),
 		edges:          make([]*Edge[T], 0),
 		adjacencyLists: make(map[T][]T),
+		adjacencySets:  make(map[T]map[T]bool),
 		kind:           kind,
 	}
 
@@ -285,6 +338,7 @@ func (g *UndirectedGraph[T]) Clone() Graph[T] {
 	newVertices := make(map[T]*Vertex[T])
 	newEdges := make([]*Edge[T], 0)
 	newAdjacencyLists := make(map[T][]T)
+	newAdjacencySets := make(map[T]map[T]bool)
 
 	// Clone vertices
 	for k, v := range g.vertices {
@@ -298,6 +352,7 @@ func (g *UndirectedGraph[T]) Clone() Graph[T] {
 			Color:              v.Color,
 			DistanceFromSource: v.DistanceFromSource,
 			Parent:             nil, // Parent will be populated a bit later
+			NearestSource:      nil, // Same goes for the nearest source
 			DotAttributes:      dotAttributes,
 			Degree:             Degree{In: v.Degree.In, Out: v.Degree.Out},
 		}
@@ -312,6 +367,12 @@ func (g *UndirectedGraph[T]) Clone() Graph[T] {
 		newParent := newVertices[v.Parent.Value]
 		newVertices[k].Parent = newParent
 	}
+	for k, v := range g.vertices {
+		if v.NearestSource == nil {
+			continue
+		}
+		newVertices[k].NearestSource = newVertices[v.NearestSource.Value]
+	}
 
 	// Clone edges
 	for _, e := range g.edges {
@@ -337,11 +398,21 @@ func (g *UndirectedGraph[T]) Clone() Graph[T] {
 		newAdjacencyLists[v] = newAdjList
 	}
 
+	// Clone adjacency sets
+	for v, adjSet := range g.adjacencySets {
+		newAdjSet := make(map[T]bool)
+		for u := range adjSet {
+			newAdjSet[u] = true
+		}
+		newAdjacencySets[v] = newAdjSet
+	}
+
 	// Create the new graph
 	g1 := UndirectedGraph[T]{
 		vertices:       newVertices,
 		edges:          newEdges,
 		adjacencyLists: newAdjacencyLists,
+		adjacencySets:  newAdjacencySets,
 		kind:           g.kind,
 	}
 
@@ -373,6 +444,7 @@ func (g *UndirectedGraph[T]) ResetVertexAttributes() {
 		v.Color = White
 		v.DistanceFromSource = 0.0
 		v.Parent = nil
+		v.NearestSource = nil
 	}
 }
 
@@ -429,6 +501,29 @@ func (g *UndirectedGraph[T]) GetNeighbourVertices(v T) []*Vertex[T] {
 	return result
 }
 
+// IsNeighbour returns a boolean indicating whether U is a direct
+// neighbour of V
+func (g *UndirectedGraph[T]) IsNeighbour(v, u T) bool {
+	return g.adjacencySets[v][u]
+}
+
+// addNeighbour adds U to the adjacency list and set of V
+func (g *UndirectedGraph[T]) addNeighbour(v, u T) {
+	g.adjacencyLists[v] = append(g.adjacencyLists[v], u)
+	if g.adjacencySets[v] == nil {
+		g.adjacencySets[v] = make(map[T]bool)
+	}
+	g.adjacencySets[v][u] = true
+}
+
+// deleteNeighbour removes U from the adjacency list and set of V
+func (g *UndirectedGraph[T]) deleteNeighbour(v, u T) {
+	g.adjacencyLists[v] = slices.DeleteFunc(g.adjacencyLists[v], func(item T) bool {
+		return item == u
+	})
+	delete(g.adjacencySets[v], u)
+}
+
 // AddVertex adds a vertex to the graph
 func (g *UndirectedGraph[T]) AddVertex(value T) *Vertex[T] {
 	if g.VertexExists(value) {
@@ -448,8 +543,9 @@ func (g *UndirectedGraph[T]) DeleteVertex(v T) {
 	}
 
 	// Delete edges in the graph, which connect V with any other
-	// vertex in the graph
-	for _, e := range g.GetEdges() {
+	// vertex in the graph. Iterate over a copy of the edges, since
+	// deleting an edge modifies the underlying slice.
+	for _, e := range slices.Clone(g.GetEdges()) {
 		if e.From == v || e.To == v {
 			g.DeleteEdge(e.From, e.To)
 		}
@@ -457,6 +553,8 @@ func (g *UndirectedGraph[T]) DeleteVertex(v T) {
 
 	// Delete the vertex itself
 	delete(g.vertices, v)
+	delete(g.adjacencyLists, v)
+	delete(g.adjacencySets, v)
 }
 
 // GetEdge returns the edge connecting the two vertices
@@ -477,24 +575,13 @@ func (g *UndirectedGraph[T]) DeleteEdge(from, to T) {
 		return
 	}
 
-	for idx, e := range g.edges {
-		if (e.From == from && e.To == to) || (e.From == to && e.To == from) {
-			g.edges = slices.Delete(g.edges, idx, idx+1)
-		}
-	}
+	g.edges = slices.DeleteFunc(g.edges, func(e *Edge[T]) bool {
+		return (e.From == from && e.To == to) || (e.From == to && e.To == from)
+	})
 
 	// Update the adjacency lists
-	for idx, v := range g.adjacencyLists[from] {
-		if v == to {
-			g.adjacencyLists[from] = slices.Delete(g.adjacencyLists[from], idx, idx+1)
-		}
-	}
-
-	for idx, v := range g.adjacencyLists[to] {
-		if v == from {
-			g.adjacencyLists[to] = slices.Delete(g.adjacencyLists[to], idx, idx+1)
-		}
-	}
+	g.deleteNeighbour(from, to)
+	g.deleteNeighbour(to, from)
 
 	// Update degree
 	fromV := g.GetVertex(from)
@@ -531,8 +618,8 @@ func (g *UndirectedGraph[T]) AddEdge(from, to T) *Edge[T] {
 	g.edges = append(g.edges, e)
 
 	// Update the adjacency lists
-	g.adjacencyLists[from] = append(g.adjacencyLists[from], to)
-	g.adjacencyLists[to] = append(g.adjacencyLists[to], from)
+	g.addNeighbour(from, to)
+	g.addNeighbour(to, from)
 
 	// Update the vertices degree
 	fromV.Degree.In += 1
@@ -571,7 +658,7 @@ func (g *DirectedGraph[T]) AddEdge(from, to T) *Edge[T] {
 	g.edges = append(g.edges, e)
 
 	// Update the adjacency lists
-	g.adjacencyLists[from] = append(g.adjacencyLists[from], to)
+	g.addNeighbour(from, to)
 
 	// Update vertices degree
 	fromV.Degree.Out += 1
@@ -580,6 +667,36 @@ func (g *DirectedGraph[T]) AddEdge(from, to T) *Edge[T] {
 	return e
 }
 
+// AddWeightedEdge adds an edge between two vertices and sets weight
+// for the edge
+func (g *DirectedGraph[T]) AddWeightedEdge(from, to T, weight float64) *Edge[T] {
+	e := g.AddEdge(from, to)
+	e.Weight = weight
+
+	return e
+}
+
+// DeleteVertex removes a vertex from the graph
+func (g *DirectedGraph[T]) DeleteVertex(v T) {
+	if !g.VertexExists(v) {
+		return
+	}
+
+	// Delete edges in the graph, which connect V with any other
+	// vertex in the graph. Iterate over a copy of the edges, since
+	// deleting an edge modifies the underlying slice.
+	for _, e := range slices.Clone(g.GetEdges()) {
+		if e.From == v || e.To == v {
+			g.DeleteEdge(e.From, e.To)
+		}
+	}
+
+	// Delete the vertex itself
+	delete(g.vertices, v)
+	delete(g.adjacencyLists, v)
+	delete(g.adjacencySets, v)
+}
+
 // EdgeExists returns a boolean indicating whether an edge between two
 // vertices exists.
 func (g *DirectedGraph[T]) EdgeExists(from, to T) bool {
@@ -610,18 +727,12 @@ func (g *DirectedGraph[T]) DeleteEdge(from, to T) {
 	}
 
 	// Remove the edge itself
-	for idx, e := range g.edges {
-		if e.From == from && e.To == to {
-			g.edges = slices.Delete(g.edges, idx, idx+1)
-		}
-	}
+	g.edges = slices.DeleteFunc(g.edges, func(e *Edge[T]) bool {
+		return e.From == from && e.To == to
+	})
 
 	// Update the adjacency lists
-	for idx, v := range g.adjacencyLists[from] {
-		if v == to {
-			g.adjacencyLists[from] = slices.Delete(g.adjacencyLists[from], idx, idx+1)
-		}
-	}
+	g.deleteNeighbour(from, to)
 
 	fromV := g.GetVertex(from)
 	fromV.Degree.Out -= 1
@@ -629,3 +740,188 @@ func (g *DirectedGraph[T]) DeleteEdge(from, to T) {
 	toV := g.GetVertex(to)
 	toV.Degree.In -= 1
 }
+
+// vertexIndex maps the vertices of a graph to consecutive integers,
+// which allows algorithms to operate on dense, slice-based
+// representations of the graph.
+type vertexIndex[T comparable] struct {
+	// The vertex values, indexed by their position
+	values []T
+
+	// The position of each vertex value
+	index map[T]int
+}
+
+// newVertexIndex creates a new index of the vertices in the graph
+func newVertexIndex[T comparable](g Graph[T]) *vertexIndex[T] {
+	values := g.GetVertexValues()
+	idx := &vertexIndex[T]{
+		values: values,
+		index:  make(map[T]int, len(values)),
+	}
+
+	for i, v := range values {
+		idx.index[v] = i
+	}
+
+	return idx
+}
+
+// newStableVertexIndex creates a new index of the vertices in the
+// graph, where the positions do not depend on the iteration order of
+// maps. Vertices are ordered by their first appearance in the edges of
+// the graph, and vertices without edges come last, ordered by their
+// string representation as formatted by fmt.Sprint. Randomized
+// algorithms use this index in order to produce the same results for
+// the same seed.
+//
+// The order of vertices without edges is only deterministic, if their
+// string representations are distinct. Vertices, which are formatted
+// the same way, keep the iteration order of the vertex map.
+func newStableVertexIndex[T comparable](g Graph[T]) *vertexIndex[T] {
+	idx := &vertexIndex[T]{
+		values: make([]T, 0, len(g.GetVertices())),
+		index:  make(map[T]int, len(g.GetVertices())),
+	}
+	add := func(v T) {
+		if _, ok := idx.index[v]; !ok {
+			idx.index[v] = len(idx.values)
+			idx.values = append(idx.values, v)
+		}
+	}
+
+	for _, e := range g.GetEdges() {
+		add(e.From)
+		add(e.To)
+	}
+
+	isolated := make([]T, 0)
+	for _, v := range g.GetVertexValues() {
+		if _, ok := idx.index[v]; !ok {
+			isolated = append(isolated, v)
+		}
+	}
+	slices.SortStableFunc(isolated, func(a, b T) int {
+		return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	})
+	for _, v := range isolated {
+		add(v)
+	}
+
+	return idx
+}
+
+// adjacency returns the adjacency lists of the graph in terms of the
+// vertex positions.
+func (idx *vertexIndex[T]) adjacency(g Graph[T]) [][]int {
+	adj := make([][]int, len(idx.values))
+	for i, v := range idx.values {
+		for _, u := range g.GetNeighbours(v) {
+			adj[i] = append(adj[i], idx.index[u])
+		}
+	}
+
+	return adj
+}
+
+// edges returns the edges of the graph as pairs of vertex
+// positions
+func (idx *vertexIndex[T]) edges(g Graph[T]) [][2]int {
+	result := make([][2]int, 0, len(g.GetEdges()))
+	for _, e := range g.GetEdges() {
+		result = append(result, [2]int{idx.index[e.From], idx.index[e.To]})
+	}
+
+	return result
+}
+
+// indexedArc represents an edge of the graph in terms of vertex
+// positions, as traversed in a given direction.
+type indexedArc[T comparable] struct {
+	// The position of the destination vertex
+	to int
+
+	// The weight of the edge
+	weight float64
+
+	// The edge, which this arc represents
+	edge *Edge[T]
+}
+
+// arcs returns the outgoing arcs of each vertex, in terms of the
+// vertex positions. Edges of undirected graphs are represented by a
+// pair of arcs, one for each direction.
+func (idx *vertexIndex[T]) arcs(g Graph[T]) [][]indexedArc[T] {
+	arcs := make([][]indexedArc[T], len(idx.values))
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		arcs[from] = append(arcs[from], indexedArc[T]{to: to, weight: e.Weight, edge: e})
+		if g.Kind() == KindUndirected && from != to {
+			arcs[to] = append(arcs[to], indexedArc[T]{to: from, weight: e.Weight, edge: e})
+		}
+	}
+
+	return arcs
+}
+
+// inducedSubgraph returns a new graph, which contains the vertices
+// satisfying the given predicate, along with all edges between them.
+// The weights and attributes of the vertices and edges are preserved.
+func inducedSubgraph[T comparable](g Graph[T], keep func(v T) bool) Graph[T] {
+	keepEdge := func(e *Edge[T]) bool {
+		return keep(e.From) && keep(e.To)
+	}
+
+	return filteredSubgraph(g, keep, keepEdge)
+}
+
+// filteredSubgraph returns a new graph, which contains the vertices
+// and edges satisfying the given predicates. Edges are only kept, if
+// both of their vertices are kept as well. The weights and attributes
+// of the vertices and edges are preserved.
+func filteredSubgraph[T comparable](g Graph[T], keepVertex func(v T) bool, keepEdge func(e *Edge[T]) bool) Graph[T] {
+	result := New[T](g.Kind())
+	for _, v := range g.GetVertices() {
+		if !keepVertex(v.Value) {
+			continue
+		}
+		newV := result.AddVertex(v.Value)
+		for k, attr := range v.DotAttributes {
+			newV.DotAttributes[k] = attr
+		}
+	}
+
+	for _, e := range g.GetEdges() {
+		if !keepEdge(e) || !keepVertex(e.From) || !keepVertex(e.To) {
+			continue
+		}
+		newE := result.AddWeightedEdge(e.From, e.To, e.Weight)
+		for k, attr := range e.DotAttributes {
+			newE.DotAttributes[k] = attr
+		}
+	}
+
+	return result
+}
+
+// reversedGraph returns a new directed graph with the direction of
+// each edge reversed. The weights and attributes of the vertices and
+// edges are preserved.
+func reversedGraph[T comparable](g Graph[T]) Graph[T] {
+	result := New[T](KindDirected)
+	for _, v := range g.GetVertices() {
+		newV := result.AddVertex(v.Value)
+		for k, attr := range v.DotAttributes {
+			newV.DotAttributes[k] = attr
+		}
+	}
+
+	for _, e := range g.GetEdges() {
+		newE := result.AddWeightedEdge(e.To, e.From, e.Weight)
+		for k, attr := range e.DotAttributes {
+			newE.DotAttributes[k] = attr
+		}
+	}
+
+	return result
+}
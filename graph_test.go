@@ -478,6 +478,63 @@ func TestDeleteEdgeDirectedGraph(t *testing.T) {
 	}
 }
 
+func TestDeleteVertexDirectedGraph(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+
+	// Deleting a vertex should only affect the edges of the vertex
+	g.DeleteVertex(2)
+	if len(g.GetVertexValues()) != 2 {
+		t.Fatal("graph must have 2 vertices")
+	}
+
+	if len(g.GetEdges()) != 1 || !g.EdgeExists(3, 1) {
+		t.Fatal("graph must have the 3 -> 1 edge only")
+	}
+
+	if len(g.GetNeighbours(1)) != 0 || len(g.GetNeighbours(2)) != 0 {
+		t.Fatal("deleted edges must be removed from the adjacency lists")
+	}
+
+	v1 := g.GetVertex(1)
+	if v1.Degree.In != 1 || v1.Degree.Out != 0 {
+		t.Fatalf("vertex 1 must have in-degree 1 and out-degree 0, got %+v", v1.Degree)
+	}
+
+	v3 := g.GetVertex(3)
+	if v3.Degree.In != 0 || v3.Degree.Out != 1 {
+		t.Fatalf("vertex 3 must have in-degree 0 and out-degree 1, got %+v", v3.Degree)
+	}
+}
+
+func TestAddWeightedEdgeDirectedGraph(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 1, 10)
+	g.AddWeightedEdge(1, 3, 5)
+
+	if len(g.GetEdges()) != 3 {
+		t.Fatalf("want 3 edges, got %d", len(g.GetEdges()))
+	}
+	if w := g.GetEdge(1, 2).Weight; w != 1 {
+		t.Fatalf("want weight 1 for edge 1->2, got %v", w)
+	}
+	if w := g.GetEdge(2, 1).Weight; w != 10 {
+		t.Fatalf("want weight 10 for edge 2->1, got %v", w)
+	}
+
+	// Weighted edges are directed as well
+	if g.EdgeExists(3, 1) || len(g.GetNeighbours(3)) != 0 {
+		t.Fatal("vertex 3 must not have outgoing edges")
+	}
+	v3 := g.GetVertex(3)
+	if v3.Degree.In != 1 || v3.Degree.Out != 0 {
+		t.Fatalf("vertex 3 must have in-degree 1 and out-degree 0, got %+v", v3.Degree)
+	}
+}
+
 func TestCloneUndirectedGraph(t *testing.T) {
 	g1 := graph.New[int](graph.KindUndirected)
 	g1.AddEdge(1, 2)
@@ -611,3 +668,36 @@ func TestCloneDirectedGraph(t *testing.T) {
 		t.Fatal("v2 and v2Prime parent values mismatch")
 	}
 }
+
+func TestIsNeighbour(t *testing.T) {
+	g1 := newUndirectedGraph()
+	if !g1.IsNeighbour(1, 2) || !g1.IsNeighbour(2, 1) {
+		t.Fatal("g1: (1) and (2) must be neighbours")
+	}
+	if g1.IsNeighbour(1, 4) {
+		t.Fatal("g1: (1) and (4) must not be neighbours")
+	}
+
+	g1.DeleteEdge(1, 2)
+	if g1.IsNeighbour(1, 2) || g1.IsNeighbour(2, 1) {
+		t.Fatal("g1: (1) and (2) must not be neighbours after deleting the edge")
+	}
+
+	g2 := newDirectedGraph()
+	if !g2.IsNeighbour(1, 2) {
+		t.Fatal("g2: (2) must be a neighbour of (1)")
+	}
+	if g2.IsNeighbour(2, 1) {
+		t.Fatal("g2: (1) must not be a neighbour of (2)")
+	}
+
+	// Cloned graphs have their own adjacency
+	g3 := g2.Clone()
+	g3.DeleteVertex(1)
+	if !g2.IsNeighbour(1, 2) || g3.IsNeighbour(1, 2) {
+		t.Fatal("g3: deleting a vertex must not affect the original graph")
+	}
+	if len(g3.GetNeighbours(1)) != 0 {
+		t.Fatal("g3: deleted vertex must not have neighbours")
+	}
+}
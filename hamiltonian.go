@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// ErrNoHamiltonianPath is returned whenever the graph does not
+// contain a Hamiltonian path.
+var ErrNoHamiltonianPath = errors.New("no hamiltonian path exists")
+
+// ErrNoHamiltonianCycle is returned whenever the graph does not
+// contain a Hamiltonian cycle.
+var ErrNoHamiltonianCycle = errors.New("no hamiltonian cycle exists")
+
+// ErrGraphTooLarge is returned whenever an exact algorithm with
+// exponential complexity is used on a graph with too many vertices.
+var ErrGraphTooLarge = errors.New("graph is too large")
+
+// MaxHamiltonianVertices is the maximum number of vertices supported
+// by HamiltonianPath and HamiltonianCycle.
+const MaxHamiltonianVertices = 20
+
+// adjacencyMasks returns the neighbours of each vertex as a bitmask
+// of vertex positions, excluding self-loops
+func adjacencyMasks(adj [][]int) []uint32 {
+	masks := make([]uint32, len(adj))
+	for v, items := range adj {
+		for _, u := range items {
+			if u != v {
+				masks[v] |= 1 << u
+			}
+		}
+	}
+
+	return masks
+}
+
+// hamiltonianReach computes for each subset of vertices the set of
+// vertices, at which a path visiting exactly the vertices of the
+// subset can end. Paths start at the given vertex position, or at
+// any vertex when start is -1.
+func hamiltonianReach(masks []uint32, start int) []uint32 {
+	n := len(masks)
+	reach := make([]uint32, 1<<n)
+	if start == -1 {
+		for v := 0; v < n; v++ {
+			reach[1<<v] = 1 << v
+		}
+	} else {
+		reach[1<<start] = 1 << start
+	}
+
+	for mask := 1; mask < 1<<n; mask++ {
+		ends := reach[mask]
+		for ends != 0 {
+			v := bits.TrailingZeros32(ends)
+			ends &= ends - 1
+
+			candidates := masks[v] &^ uint32(mask)
+			for candidates != 0 {
+				u := bits.TrailingZeros32(candidates)
+				candidates &= candidates - 1
+				reach[mask|1<<u] |= 1 << u
+			}
+		}
+	}
+
+	return reach
+}
+
+// hamiltonianWalkBack reconstructs a path visiting the vertices of
+// the given subset, which ends at vertex position V.
+func hamiltonianWalkBack(masks []uint32, reach []uint32, mask uint32, v int) []int {
+	path := []int{v}
+	for bits.OnesCount32(mask) > 1 {
+		prevMask := mask &^ (1 << v)
+		candidates := reach[prevMask]
+		for candidates != 0 {
+			u := bits.TrailingZeros32(candidates)
+			candidates &= candidates - 1
+			if masks[u]&(1<<v) != 0 {
+				v = u
+				break
+			}
+		}
+		mask = prevMask
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+// checkExactSize returns an error, if the graph has more vertices
+// than supported by an exact algorithm
+func checkExactSize(n, limit int) error {
+	if n > limit {
+		return fmt.Errorf("%w: %d vertices, exact algorithm supports at most %d", ErrGraphTooLarge, n, limit)
+	}
+
+	return nil
+}
+
+// HamiltonianPath returns a path, which visits each vertex of the
+// graph exactly once, using the dynamic programming algorithm of
+// Held and Karp over subsets of vertices.
+//
+// The algorithm takes O(2^n * n^2) time, and supports graphs with up
+// to MaxHamiltonianVertices vertices. Returns ErrNoHamiltonianPath,
+// if no such path exists.
+func HamiltonianPath[T comparable](g Graph[T]) ([]T, error) {
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	if err := checkExactSize(n, MaxHamiltonianVertices); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []T{}, nil
+	}
+
+	masks := adjacencyMasks(idx.adjacency(g))
+	reach := hamiltonianReach(masks, -1)
+	full := uint32(1<<n - 1)
+	if reach[full] == 0 {
+		return nil, ErrNoHamiltonianPath
+	}
+
+	end := bits.TrailingZeros32(reach[full])
+	path := hamiltonianWalkBack(masks, reach, full, end)
+
+	return trailValues(idx, path), nil
+}
+
+// HamiltonianCycle returns a cycle, which visits each vertex of the
+// graph exactly once, using the dynamic programming algorithm of
+// Held and Karp over subsets of vertices. The first and the last
+// vertex of the result are the same.
+//
+// The algorithm takes O(2^n * n^2) time, and supports graphs with up
+// to MaxHamiltonianVertices vertices. Returns ErrNoHamiltonianCycle,
+// if no such cycle exists.
+func HamiltonianCycle[T comparable](g Graph[T]) ([]T, error) {
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	if err := checkExactSize(n, MaxHamiltonianVertices); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return []T{}, nil
+	}
+
+	// A cycle in an undirected graph cannot use the same edge
+	// twice, so it requires at least three vertices
+	if g.Kind() == KindUndirected && n < 3 {
+		return nil, ErrNoHamiltonianCycle
+	}
+
+	masks := adjacencyMasks(idx.adjacency(g))
+	if n == 1 {
+		if g.EdgeExists(idx.values[0], idx.values[0]) {
+			return []T{idx.values[0], idx.values[0]}, nil
+		}
+		return nil, ErrNoHamiltonianCycle
+	}
+
+	// Every cycle passes through the first vertex, so paths start
+	// from it and need to be closed by an edge back to it
+	reach := hamiltonianReach(masks, 0)
+	full := uint32(1<<n - 1)
+	ends := reach[full]
+	for ends != 0 {
+		v := bits.TrailingZeros32(ends)
+		ends &= ends - 1
+		if masks[v]&1 == 0 {
+			continue
+		}
+
+		cycle := hamiltonianWalkBack(masks, reach, full, v)
+		cycle = append(cycle, 0)
+
+		return trailValues(idx, cycle), nil
+	}
+
+	return nil, ErrNoHamiltonianCycle
+}
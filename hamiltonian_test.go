@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the given path visits each
+// vertex of the graph exactly once
+func verifyHamiltonianPath[T comparable](t *testing.T, g graph.Graph[T], path []T) {
+	if len(path) != len(g.GetVertices()) {
+		t.Fatalf("want path of %d vertices, got %d", len(g.GetVertices()), len(path))
+	}
+
+	seen := make(map[T]bool)
+	for i, v := range path {
+		if seen[v] {
+			t.Fatalf("vertex %v visited more than once", v)
+		}
+		seen[v] = true
+		if i > 0 && !g.EdgeExists(path[i-1], v) {
+			t.Fatalf("no edge between %v and %v", path[i-1], v)
+		}
+	}
+}
+
+// A helper function which checks each permutation of the vertices of
+// the graph for a Hamiltonian path or cycle
+func bruteForceHamiltonian(g graph.Graph[int], cycle bool) bool {
+	n := len(g.GetVertices())
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	var permute func(k int) bool
+	permute = func(k int) bool {
+		if k > 1 && !g.EdgeExists(perm[k-2], perm[k-1]) {
+			return false
+		}
+		if k == n {
+			return !cycle || g.EdgeExists(perm[n-1], perm[0])
+		}
+		for i := k; i < n; i++ {
+			perm[k], perm[i] = perm[i], perm[k]
+			if permute(k + 1) {
+				return true
+			}
+			perm[k], perm[i] = perm[i], perm[k]
+		}
+		return false
+	}
+
+	return permute(0)
+}
+
+func TestHamiltonianPath(t *testing.T) {
+	// Star graph has no Hamiltonian path
+	star := graph.New[int](graph.KindUndirected)
+	star.AddEdge(1, 2)
+	star.AddEdge(1, 3)
+	star.AddEdge(1, 4)
+	if _, err := graph.HamiltonianPath(star); !errors.Is(err, graph.ErrNoHamiltonianPath) {
+		t.Fatalf("want ErrNoHamiltonianPath, got %v", err)
+	}
+
+	// Directed path must follow the direction of the edges
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("c", "a")
+	g.AddEdge("a", "d")
+	g.AddEdge("d", "b")
+	g.AddEdge("b", "a")
+	path, err := graph.HamiltonianPath(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyHamiltonianPath(t, g, path)
+	if path[0] != "c" {
+		t.Fatalf("want path starting at c, got %v", path)
+	}
+
+	// Too many vertices
+	large := graph.New[int](graph.KindUndirected)
+	for i := 0; i <= graph.MaxHamiltonianVertices; i++ {
+		large.AddEdge(i, i+1)
+	}
+	if _, err := graph.HamiltonianPath(large); !errors.Is(err, graph.ErrGraphTooLarge) {
+		t.Fatalf("want ErrGraphTooLarge, got %v", err)
+	}
+}
+
+func TestHamiltonianCycle(t *testing.T) {
+	// Petersen graph has a Hamiltonian path, but no cycle
+	g := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 5; i++ {
+		g.AddEdge(i, (i+1)%5)
+		g.AddEdge(i, i+5)
+		g.AddEdge(i+5, (i+2)%5+5)
+	}
+	if _, err := graph.HamiltonianCycle(g); !errors.Is(err, graph.ErrNoHamiltonianCycle) {
+		t.Fatalf("want ErrNoHamiltonianCycle, got %v", err)
+	}
+	path, err := graph.HamiltonianPath(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyHamiltonianPath(t, g, path)
+
+	// The 3-dimensional cube graph has a Hamiltonian cycle
+	cube := graph.New[int](graph.KindUndirected)
+	for v := 0; v < 8; v++ {
+		for bit := 1; bit < 8; bit <<= 1 {
+			if v < v^bit {
+				cube.AddEdge(v, v^bit)
+			}
+		}
+	}
+	cycle, err := graph.HamiltonianCycle(cube)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cycle[0] != cycle[len(cycle)-1] {
+		t.Fatalf("want closed cycle, got %v", cycle)
+	}
+	verifyHamiltonianPath(t, cube, cycle[:len(cycle)-1])
+	if !cube.EdgeExists(cycle[len(cycle)-2], cycle[0]) {
+		t.Fatalf("cycle %v is not closed", cycle)
+	}
+}
+
+func TestHamiltonianRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(36))
+	for iter := 0; iter < 50; iter++ {
+		g := newRandomUndirectedGraph(r, 7, 0.4)
+
+		path, err := graph.HamiltonianPath(g)
+		if want := bruteForceHamiltonian(g, false); want != (err == nil) {
+			t.Fatalf("want Hamiltonian path %v, got %v", want, err)
+		}
+		if err == nil {
+			verifyHamiltonianPath(t, g, path)
+		}
+
+		cycle, err := graph.HamiltonianCycle(g)
+		if want := bruteForceHamiltonian(g, true); want != (err == nil) {
+			t.Fatalf("want Hamiltonian cycle %v, got %v", want, err)
+		}
+		if err == nil {
+			verifyHamiltonianPath(t, g, cycle[:len(cycle)-1])
+			if cycle[0] != cycle[len(cycle)-1] {
+				t.Fatalf("want closed cycle, got %v", cycle)
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+// coreDecomposition implements the algorithm of Batagelj and
+// Zaversnik, which computes the core number of each vertex in O(V+E)
+// time. The incident slice contains the other endpoint of each edge
+// incident to a vertex, and degree contains the initial degree of
+// each vertex, which must be equal to the length of its incident
+// slice.
+//
+// Returns the order in which vertices have been removed, which is a
+// degeneracy ordering, and the core number of each vertex.
+func coreDecomposition(incident [][]int, degree []int) ([]int, []int) {
+	n := len(incident)
+	core := make([]int, n)
+	copy(core, degree)
+
+	maxDegree := 0
+	for _, d := range core {
+		maxDegree = max(maxDegree, d)
+	}
+
+	// Sort the vertices by degree using bin sort, where bin[d]
+	// is the starting position of the vertices with degree d
+	bin := make([]int, maxDegree+1)
+	for _, d := range core {
+		bin[d]++
+	}
+	start := 0
+	for d := range bin {
+		count := bin[d]
+		bin[d] = start
+		start += count
+	}
+
+	pos := make([]int, n)
+	vert := make([]int, n)
+	for v, d := range core {
+		pos[v] = bin[d]
+		vert[pos[v]] = v
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	// Process the vertices in order of increasing degree, and move
+	// each neighbour with a larger degree one bin lower
+	for i := 0; i < n; i++ {
+		v := vert[i]
+		for _, u := range incident[v] {
+			if core[u] <= core[v] {
+				continue
+			}
+
+			du := core[u]
+			pu := pos[u]
+			pw := bin[du]
+			w := vert[pw]
+			if u != w {
+				pos[u] = pw
+				vert[pu] = w
+				pos[w] = pu
+				vert[pw] = u
+			}
+			bin[du]++
+			core[u]--
+		}
+	}
+
+	return vert, core
+}
+
+// incidentVertices returns the other endpoint of each edge incident
+// to a vertex in terms of vertex positions, along with the degree of
+// each vertex as tracked by the graph. Edges of directed graphs are
+// considered in both directions, and self-loops are ignored.
+func incidentVertices[T comparable](g Graph[T], idx *vertexIndex[T]) ([][]int, []int) {
+	n := len(idx.values)
+	incident := make([][]int, n)
+	degree := make([]int, n)
+	for i, v := range idx.values {
+		d := g.GetVertex(v).Degree
+		if g.Kind() == KindUndirected {
+			degree[i] = d.Out
+		} else {
+			degree[i] = d.In + d.Out
+		}
+	}
+
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from == to {
+			// Self-loops contribute to the degree of the
+			// vertex on both ends of the edge
+			degree[from] -= 2
+			continue
+		}
+		incident[from] = append(incident[from], to)
+		incident[to] = append(incident[to], from)
+	}
+
+	return incident, degree
+}
+
+// CoreNumbers returns the core number of each vertex in the graph.
+// The k-core of a graph is the maximal subgraph, in which each vertex
+// has a degree of at least k. The core number of a vertex is the
+// largest k, for which the vertex belongs to the k-core.
+//
+// The degree of a vertex in a directed graph is the sum of its in-
+// and out-degree. Self-loops are ignored.
+func CoreNumbers[T comparable](g Graph[T]) map[T]int {
+	idx := newVertexIndex(g)
+	_, core := coreDecomposition(incidentVertices(g, idx))
+
+	result := make(map[T]int, len(idx.values))
+	for i, v := range idx.values {
+		result[v] = core[i]
+	}
+
+	return result
+}
+
+// DegeneracyOrdering returns the vertices of the graph in the order
+// of repeatedly removing a vertex of minimum degree. Each vertex has
+// at most d neighbours, which come later in the ordering, where d is
+// the degeneracy of the graph.
+func DegeneracyOrdering[T comparable](g Graph[T]) []T {
+	idx := newVertexIndex(g)
+	order, _ := coreDecomposition(incidentVertices(g, idx))
+
+	result := make([]T, 0, len(order))
+	for _, v := range order {
+		result = append(result, idx.values[v])
+	}
+
+	return result
+}
+
+// Degeneracy returns the degeneracy of the graph, which is the
+// largest core number of its vertices.
+func Degeneracy[T comparable](g Graph[T]) int {
+	result := 0
+	for _, core := range CoreNumbers(g) {
+		result = max(result, core)
+	}
+
+	return result
+}
+
+// KCore returns the k-core of the graph, which is the subgraph
+// induced by the vertices with core number of at least k.
+func KCore[T comparable](g Graph[T], k int) Graph[T] {
+	core := CoreNumbers(g)
+	keep := func(v T) bool {
+		return core[v] >= k
+	}
+
+	return inducedSubgraph(g, keep)
+}
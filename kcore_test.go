@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Computes the core numbers of the vertices in an undirected graph by
+// repeatedly peeling vertices with degree less than k
+func bruteForceCoreNumbers(g graph.Graph[int]) map[int]int {
+	result := make(map[int]int)
+	for k := 0; ; k++ {
+		alive := make(map[int]bool)
+		for _, v := range g.GetVertexValues() {
+			alive[v] = true
+		}
+
+		for changed := true; changed; {
+			changed = false
+			for v := range alive {
+				degree := 0
+				for _, u := range g.GetNeighbours(v) {
+					if alive[u] {
+						degree++
+					}
+				}
+				if degree < k {
+					delete(alive, v)
+					changed = true
+				}
+			}
+		}
+
+		if len(alive) == 0 {
+			return result
+		}
+		for v := range alive {
+			result[v] = k
+		}
+	}
+}
+
+func TestCoreNumbers(t *testing.T) {
+	// A 4-clique with a pendant path
+	g := graph.New[int](graph.KindUndirected)
+	for i := 1; i <= 4; i++ {
+		for j := i + 1; j <= 4; j++ {
+			g.AddEdge(i, j)
+		}
+	}
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+	g.AddVertex(7)
+
+	got := graph.CoreNumbers(g)
+	want := map[int]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 1, 6: 1, 7: 0}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("want core number %d for vertex %d, got %d", v, k, got[k])
+		}
+	}
+	if d := graph.Degeneracy(g); d != 3 {
+		t.Fatalf("want degeneracy 3, got %d", d)
+	}
+
+	// Self-loops are ignored
+	g.AddEdge(6, 6)
+	if got := graph.CoreNumbers(g); got[6] != 1 {
+		t.Fatalf("want core number 1 for vertex 6, got %d", got[6])
+	}
+
+	// Cross-check against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		g := newRandomUndirectedGraph(r, 25, r.Float64()*0.5)
+		want := bruteForceCoreNumbers(g)
+		got := graph.CoreNumbers(g)
+		for k, v := range want {
+			if got[k] != v {
+				t.Fatalf("want core number %d for vertex %d, got %d", v, k, got[k])
+			}
+		}
+	}
+
+	// Directed graphs use the sum of in- and out-degree
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 1)
+	d.AddEdge(2, 3)
+	got = graph.CoreNumbers(d)
+	want = map[int]int{1: 2, 2: 2, 3: 1}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("want core number %d for vertex %d, got %d", v, k, got[k])
+		}
+	}
+}
+
+func TestDegeneracyOrdering(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		g := newRandomUndirectedGraph(r, 30, r.Float64()*0.5)
+		degeneracy := graph.Degeneracy(g)
+		order := graph.DegeneracyOrdering(g)
+		if len(order) != 30 {
+			t.Fatalf("want 30 vertices in ordering, got %d", len(order))
+		}
+
+		// Each vertex has at most d neighbours later in the
+		// ordering
+		for i, v := range order {
+			later := 0
+			for _, u := range g.GetNeighbours(v) {
+				if slices.Index(order, u) > i {
+					later++
+				}
+			}
+			if later > degeneracy {
+				t.Fatalf("vertex %d has %d later neighbours, want at most %d", v, later, degeneracy)
+			}
+		}
+	}
+}
+
+func TestKCore(t *testing.T) {
+	g := graph.New[int](graph.KindUndirected)
+	for i := 1; i <= 4; i++ {
+		for j := i + 1; j <= 4; j++ {
+			g.AddWeightedEdge(i, j, float64(i+j))
+		}
+	}
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+	g.GetVertex(1).DotAttributes["color"] = "red"
+	g.GetEdge(1, 2).DotAttributes["label"] = "one-two"
+
+	core := graph.KCore(g, 3)
+	if core.Kind() != graph.KindUndirected {
+		t.Fatal("k-core must be undirected")
+	}
+	values := core.GetVertexValues()
+	slices.Sort(values)
+	if !slices.Equal(values, []int{1, 2, 3, 4}) {
+		t.Fatalf("want 3-core vertices [1 2 3 4], got %v", values)
+	}
+	if len(core.GetEdges()) != 6 {
+		t.Fatalf("want 6 edges in 3-core, got %d", len(core.GetEdges()))
+	}
+
+	// Weights and attributes are preserved, without affecting
+	// the original graph
+	if core.GetEdge(2, 3).Weight != 5 {
+		t.Fatalf("want weight 5, got %.2f", core.GetEdge(2, 3).Weight)
+	}
+	if core.GetVertex(1).DotAttributes["color"] != "red" || core.GetEdge(1, 2).DotAttributes["label"] != "one-two" {
+		t.Fatal("dot attributes must be preserved")
+	}
+	core.GetVertex(1).DotAttributes["color"] = "blue"
+	if g.GetVertex(1).DotAttributes["color"] != "red" {
+		t.Fatal("k-core must not share attributes with the original graph")
+	}
+
+	if got := graph.KCore(g, 4); len(got.GetVertices()) != 0 {
+		t.Fatal("4-core must be empty")
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrNoCommonAncestor is returned whenever two vertices do not have a
+// common ancestor.
+var ErrNoCommonAncestor = errors.New("no common ancestor exists")
+
+// TreeLCA answers lowest common ancestor queries over a rooted forest
+// in O(log n) time using binary lifting.
+//
+// The forest is given by the Parent field of the vertices, which is
+// populated by walking the graph, e.g. with WalkBFS or
+// WalkPreOrderDFS. Vertices without a parent are the roots. The
+// queries operate on a snapshot of the parents at the time of
+// creation.
+type TreeLCA[T comparable] struct {
+	idx   *vertexIndex[T]
+	depth []int
+
+	// up[k][v] is the ancestor of V, which is 2^k levels above
+	// it, or the root of its tree
+	up [][]int
+}
+
+// NewTreeLCA creates a new index for lowest common ancestor queries
+// over the forest formed by the Parent field of the vertices. Returns
+// ErrCycleDetected, if the parents do not form a forest.
+func NewTreeLCA[T comparable](g Graph[T]) (*TreeLCA[T], error) {
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+
+	parent := make([]int, n)
+	children := make([][]int, n)
+	roots := make([]int, 0)
+	for i, v := range idx.values {
+		p := g.GetVertex(v).Parent
+		if p == nil {
+			parent[i] = i
+			roots = append(roots, i)
+			continue
+		}
+		pi, ok := idx.index[p.Value]
+		if !ok {
+			return nil, fmt.Errorf("Parent vertex %v not found in the graph", p.Value)
+		}
+		parent[i] = pi
+		children[pi] = append(children[pi], i)
+	}
+
+	// Compute the depths starting from the roots. Vertices which
+	// are not reached lie on a cycle of parents.
+	depth := make([]int, n)
+	reached := len(roots)
+	queue := roots
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, u := range children[v] {
+			depth[u] = depth[v] + 1
+			queue = append(queue, u)
+			reached++
+		}
+	}
+	if reached != n {
+		return nil, ErrCycleDetected
+	}
+
+	levels := max(1, bits.Len(uint(n)))
+	up := make([][]int, levels)
+	up[0] = parent
+	for k := 1; k < levels; k++ {
+		up[k] = make([]int, n)
+		for v := 0; v < n; v++ {
+			up[k][v] = up[k-1][up[k-1][v]]
+		}
+	}
+
+	lca := &TreeLCA[T]{
+		idx:   idx,
+		depth: depth,
+		up:    up,
+	}
+
+	return lca, nil
+}
+
+// LCA returns the lowest common ancestor of the two vertices, which
+// is the deepest vertex having both of them as descendants. A vertex
+// is considered a descendant of itself. Returns ErrNoCommonAncestor,
+// if the vertices belong to different trees.
+func (l *TreeLCA[T]) LCA(u, v T) (T, error) {
+	var zero T
+	a, ok := l.idx.index[u]
+	if !ok {
+		return zero, fmt.Errorf("Vertex %v not found in the graph", u)
+	}
+	b, ok := l.idx.index[v]
+	if !ok {
+		return zero, fmt.Errorf("Vertex %v not found in the graph", v)
+	}
+
+	// Lift the deeper vertex to the depth of the other one
+	if l.depth[a] < l.depth[b] {
+		a, b = b, a
+	}
+	for k, diff := 0, l.depth[a]-l.depth[b]; diff > 0; k, diff = k+1, diff>>1 {
+		if diff&1 != 0 {
+			a = l.up[k][a]
+		}
+	}
+
+	// Lift both vertices to just below their lowest common
+	// ancestor
+	if a != b {
+		for k := len(l.up) - 1; k >= 0; k-- {
+			if l.up[k][a] != l.up[k][b] {
+				a = l.up[k][a]
+				b = l.up[k][b]
+			}
+		}
+		a = l.up[0][a]
+		b = l.up[0][b]
+	}
+	if a != b {
+		return zero, fmt.Errorf("%w: %v and %v are in different trees", ErrNoCommonAncestor, u, v)
+	}
+
+	return l.idx.values[a], nil
+}
+
+// Depth returns the number of edges between the vertex and the root
+// of its tree
+func (l *TreeLCA[T]) Depth(v T) (int, error) {
+	i, ok := l.idx.index[v]
+	if !ok {
+		return 0, fmt.Errorf("Vertex %v not found in the graph", v)
+	}
+
+	return l.depth[i], nil
+}
+
+// DAGLCA answers lowest common ancestor queries over a directed
+// acyclic graph, where an edge leads from an ancestor to its
+// descendant. Unlike in trees, two vertices may have several lowest
+// common ancestors, e.g. merge bases of two branches in a version
+// history with criss-cross merges.
+//
+// The ancestors of each vertex are precomputed as bitsets, which
+// takes O(n * m / 64) time and O(n^2 / 64) memory. Each query
+// intersects the ancestors of the two vertices, and removes the
+// strict ancestors of each of the k common ancestors, which takes
+// O((k + 1) * n / 64) time.
+type DAGLCA[T comparable] struct {
+	idx *vertexIndex[T]
+
+	// The ancestors of each vertex, including the vertex itself
+	ancestors []bitset
+
+	// The strict ancestors of each vertex, excluding the vertex
+	// itself
+	strict []bitset
+
+	// The number of edges along the longest path from a vertex
+	// without ancestors
+	depth []int
+}
+
+// NewDAGLCA creates a new index for lowest common ancestor queries
+// over the directed acyclic graph. Returns ErrCycleDetected, if the
+// graph contains a cycle.
+func NewDAGLCA[T comparable](g Graph[T]) (*DAGLCA[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	adj := idx.adjacency(g)
+
+	// Each component of an acyclic graph is a single vertex, and
+	// the components are numbered in reverse topological order
+	comp, count := stronglyConnectedComponents(adj)
+	if count != n {
+		return nil, ErrCycleDetected
+	}
+	order := make([]int, n)
+	for v, c := range comp {
+		order[n-1-c] = v
+	}
+
+	ancestors := make([]bitset, n)
+	strict := make([]bitset, n)
+	depth := make([]int, n)
+	for v := range strict {
+		strict[v] = newBitset(n)
+	}
+	for _, v := range order {
+		// All predecessors of V precede it in the order
+		ancestors[v] = newBitset(n)
+		ancestors[v].union(strict[v])
+		ancestors[v].set(v)
+		for _, u := range adj[v] {
+			if u == v {
+				return nil, ErrCycleDetected
+			}
+			strict[u].union(ancestors[v])
+			depth[u] = max(depth[u], depth[v]+1)
+		}
+	}
+
+	lca := &DAGLCA[T]{
+		idx:       idx,
+		ancestors: ancestors,
+		strict:    strict,
+		depth:     depth,
+	}
+
+	return lca, nil
+}
+
+// lowest returns the positions of the lowest common ancestors of the
+// two vertices
+func (l *DAGLCA[T]) lowest(u, v T) ([]int, error) {
+	a, ok := l.idx.index[u]
+	if !ok {
+		return nil, fmt.Errorf("Vertex %v not found in the graph", u)
+	}
+	b, ok := l.idx.index[v]
+	if !ok {
+		return nil, fmt.Errorf("Vertex %v not found in the graph", v)
+	}
+
+	common := newBitset(len(l.idx.values))
+	common.union(l.ancestors[a])
+	common.intersect(l.ancestors[b])
+
+	// Remove the strict ancestors of each common ancestor, which
+	// leaves the lowest ones
+	strict := newBitset(len(l.idx.values))
+	common.forEach(func(w int) {
+		strict.union(l.strict[w])
+	})
+	common.difference(strict)
+
+	result := make([]int, 0)
+	common.forEach(func(w int) {
+		result = append(result, w)
+	})
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: %v and %v", ErrNoCommonAncestor, u, v)
+	}
+
+	return result, nil
+}
+
+// AllLCAs returns all lowest common ancestors of the two vertices. A
+// common ancestor is lowest, if it is not an ancestor of another
+// common ancestor. A vertex is considered an ancestor of itself.
+// Returns ErrNoCommonAncestor, if the vertices have no common
+// ancestor.
+func (l *DAGLCA[T]) AllLCAs(u, v T) ([]T, error) {
+	lowest, err := l.lowest(u, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return trailValues(l.idx, lowest), nil
+}
+
+// LCA returns a representative lowest common ancestor of the two
+// vertices, which is the one farthest from the vertices without
+// ancestors. Ties are broken arbitrarily. Returns
+// ErrNoCommonAncestor, if the vertices have no common ancestor.
+func (l *DAGLCA[T]) LCA(u, v T) (T, error) {
+	lowest, err := l.lowest(u, v)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	best := lowest[0]
+	for _, w := range lowest[1:] {
+		if l.depth[w] > l.depth[best] {
+			best = w
+		}
+	}
+
+	return l.idx.values[best], nil
+}
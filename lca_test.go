@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+func TestTreeLCA(t *testing.T) {
+	r := rand.New(rand.NewSource(40))
+	g := graph.New[int](graph.KindUndirected)
+	for i := 1; i < 200; i++ {
+		g.AddEdge(r.Intn(i), i)
+	}
+	g.AddVertex(1000)
+
+	walkFunc := func(v *graph.Vertex[int]) error {
+		return nil
+	}
+	if err := graph.WalkBFS(g, 0, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+
+	lca, err := graph.NewTreeLCA(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Naive LCA, by walking up the parents
+	naive := func(u, v int) int {
+		seen := make(map[int]bool)
+		for x := g.GetVertex(u); x != nil; x = x.Parent {
+			seen[x.Value] = true
+		}
+		x := g.GetVertex(v)
+		for !seen[x.Value] {
+			x = x.Parent
+		}
+		return x.Value
+	}
+
+	for iter := 0; iter < 500; iter++ {
+		u, v := r.Intn(200), r.Intn(200)
+		got, err := lca.LCA(u, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := naive(u, v); got != want {
+			t.Fatalf("want LCA(%v, %v) %v, got %v", u, v, want, got)
+		}
+
+		depth, err := lca.Depth(u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int(g.GetVertex(u).DistanceFromSource); depth != want {
+			t.Fatalf("want depth %v for %v, got %v", want, u, depth)
+		}
+	}
+
+	if _, err := lca.LCA(1, 1000); !errors.Is(err, graph.ErrNoCommonAncestor) {
+		t.Fatalf("want ErrNoCommonAncestor, got %v", err)
+	}
+	if _, err := lca.LCA(1, 2000); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+}
+
+func TestDAGLCA(t *testing.T) {
+	// Criss-cross merge, where both b and c are merge bases of d
+	// and e
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("b", "e")
+	g.AddEdge("c", "e")
+	g.AddEdge("e", "f")
+	g.AddVertex("x")
+
+	lca, err := graph.NewDAGLCA(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := lca.AllLCAs("d", "f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(all)
+	if !slices.Equal(all, []string{"b", "c"}) {
+		t.Fatalf("want LCAs [b c], got %v", all)
+	}
+
+	tests := []struct {
+		u, v string
+		want string
+	}{
+		{"d", "b", "b"},
+		{"f", "e", "e"},
+		{"b", "c", "a"},
+		{"a", "a", "a"},
+	}
+	for _, test := range tests {
+		got, err := lca.LCA(test.u, test.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Fatalf("want LCA(%v, %v) %v, got %v", test.u, test.v, test.want, got)
+		}
+	}
+
+	if _, err := lca.LCA("d", "x"); !errors.Is(err, graph.ErrNoCommonAncestor) {
+		t.Fatalf("want ErrNoCommonAncestor, got %v", err)
+	}
+
+	g.AddEdge("f", "a")
+	if _, err := graph.NewDAGLCA(g); !errors.Is(err, graph.ErrCycleDetected) {
+		t.Fatalf("want ErrCycleDetected, got %v", err)
+	}
+}
+
+func TestDAGLCARandom(t *testing.T) {
+	r := rand.New(rand.NewSource(40))
+	for iter := 0; iter < 10; iter++ {
+		g := newRandomDirectedGraph(r, 15, 0.2, true)
+		reach := bruteForceReachable(g)
+		isAncestor := func(a, v int) bool {
+			return a == v || reach[[2]int{a, v}]
+		}
+
+		lca, err := graph.NewDAGLCA(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for u := 0; u < 15; u++ {
+			for v := 0; v < 15; v++ {
+				common := make([]int, 0)
+				for w := 0; w < 15; w++ {
+					if isAncestor(w, u) && isAncestor(w, v) {
+						common = append(common, w)
+					}
+				}
+				want := make([]int, 0)
+				for _, w := range common {
+					lowest := true
+					for _, other := range common {
+						if other != w && isAncestor(w, other) {
+							lowest = false
+						}
+					}
+					if lowest {
+						want = append(want, w)
+					}
+				}
+
+				got, err := lca.AllLCAs(u, v)
+				if len(want) == 0 {
+					if !errors.Is(err, graph.ErrNoCommonAncestor) {
+						t.Fatalf("want ErrNoCommonAncestor, got %v", err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				slices.Sort(got)
+				if !slices.Equal(got, want) {
+					t.Fatalf("want LCAs(%v, %v) %v, got %v", u, v, want, got)
+				}
+
+				rep, err := lca.LCA(u, v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !slices.Contains(want, rep) {
+					t.Fatalf("want LCA(%v, %v) in %v, got %v", u, v, want, rep)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,948 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"slices"
+
+	"gopkg.in/dnaeon/go-deque.v1"
+)
+
+// ErrIsNotUndirectedGraph is returned whenever an operation cannot be
+// performed, because the graph is not undirected.
+var ErrIsNotUndirectedGraph = errors.New("graph is not undirected")
+
+// MaxMatching returns a maximum cardinality matching of the given
+// undirected graph, using Edmonds' blossom algorithm.
+//
+// The result contains the edges of the graph, which form the
+// matching. Self-loops are never part of a matching.
+func MaxMatching[T comparable](g Graph[T]) ([]*Edge[T], error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	adj := idx.adjacency(g)
+	n := len(idx.values)
+
+	match := make([]int, n)
+	parent := make([]int, n)
+	base := make([]int, n)
+	used := make([]bool, n)
+	blossom := make([]bool, n)
+	for i := range match {
+		match[i] = -1
+	}
+
+	// Finds the lowest common ancestor of A and B in the
+	// alternating tree, taking contracted blossoms into account.
+	lca := func(a, b int) int {
+		seen := make([]bool, n)
+		for {
+			a = base[a]
+			seen[a] = true
+			if match[a] == -1 {
+				break
+			}
+			a = parent[match[a]]
+		}
+		for {
+			b = base[b]
+			if seen[b] {
+				return b
+			}
+			b = parent[match[b]]
+		}
+	}
+
+	// Marks the vertices on the path from V to the base of the
+	// blossom, while re-pointing the parents along the path.
+	markPath := func(v, b, child int) {
+		for base[v] != b {
+			blossom[base[v]] = true
+			blossom[base[match[v]]] = true
+			parent[v] = child
+			child = match[v]
+			v = parent[match[v]]
+		}
+	}
+
+	// Searches for an augmenting path starting from the given
+	// exposed root vertex. Returns the exposed vertex at the end
+	// of the path, or -1 if no augmenting path exists.
+	findPath := func(root int) int {
+		for i := 0; i < n; i++ {
+			used[i] = false
+			parent[i] = -1
+			base[i] = i
+		}
+
+		used[root] = true
+		queue := deque.New[int]()
+		queue.PushBack(root)
+
+		for !queue.IsEmpty() {
+			v, err := queue.PopFront()
+			if err != nil {
+				panic(err)
+			}
+
+			for _, u := range adj[v] {
+				if base[v] == base[u] || match[v] == u {
+					continue
+				}
+
+				if u == root || (match[u] != -1 && parent[match[u]] != -1) {
+					// Found a blossom, contract it
+					curBase := lca(v, u)
+					for i := range blossom {
+						blossom[i] = false
+					}
+					markPath(v, curBase, u)
+					markPath(u, curBase, v)
+					for i := 0; i < n; i++ {
+						if blossom[base[i]] {
+							base[i] = curBase
+							if !used[i] {
+								used[i] = true
+								queue.PushBack(i)
+							}
+						}
+					}
+				} else if parent[u] == -1 {
+					parent[u] = v
+					if match[u] == -1 {
+						return u
+					}
+					used[match[u]] = true
+					queue.PushBack(match[u])
+				}
+			}
+		}
+
+		return -1
+	}
+
+	// Start with a greedy matching, which is then improved by
+	// searching for augmenting paths.
+	for v := 0; v < n; v++ {
+		if match[v] != -1 {
+			continue
+		}
+		for _, u := range adj[v] {
+			if u != v && match[u] == -1 {
+				match[u] = v
+				match[v] = u
+				break
+			}
+		}
+	}
+
+	for root := 0; root < n; root++ {
+		if match[root] != -1 {
+			continue
+		}
+
+		// Augment the matching along the found path
+		v := findPath(root)
+		for v != -1 {
+			pv := parent[v]
+			next := match[pv]
+			match[v] = pv
+			match[pv] = v
+			v = next
+		}
+	}
+
+	result := make([]*Edge[T], 0)
+	for v := 0; v < n; v++ {
+		if match[v] > v {
+			result = append(result, g.GetEdge(idx.values[v], idx.values[match[v]]))
+		}
+	}
+
+	return result, nil
+}
+
+// MaxWeightMatching returns a maximum weight matching of the given
+// undirected graph, using the primal-dual variant of Edmonds' blossom
+// algorithm, which runs in O(V^3) time.
+//
+// When maxCardinality is true, the result is a maximum weight
+// matching among all maximum cardinality matchings.
+//
+// The result contains the edges of the graph, which form the
+// matching. Self-loops are never part of a matching.
+func MaxWeightMatching[T comparable](g Graph[T], maxCardinality bool) ([]*Edge[T], error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	edges := make([]*Edge[T], 0)
+	for _, e := range g.GetEdges() {
+		if e.From != e.To {
+			edges = append(edges, e)
+		}
+	}
+
+	if len(edges) == 0 {
+		return []*Edge[T]{}, nil
+	}
+
+	m := newWeightedMatcher(len(idx.values), len(edges), maxCardinality)
+	for k, e := range edges {
+		m.addEdge(k, idx.index[e.From], idx.index[e.To], e.Weight)
+	}
+	mate := m.solve()
+
+	result := make([]*Edge[T], 0)
+	for v, p := range mate {
+		if p == -1 {
+			continue
+		}
+		k := p / 2
+		if m.edgeFrom[k] == v {
+			result = append(result, edges[k])
+		}
+	}
+
+	return result, nil
+}
+
+// weightedMatcher contains the state of the maximum weight matching
+// algorithm.
+//
+// Vertices are numbered 0 .. n-1, and non-trivial blossoms are
+// numbered n .. 2n-1. Edge K connects vertices edgeFrom[K] and
+// edgeTo[K], and its endpoints are numbered 2K (edgeFrom[K]) and
+// 2K+1 (edgeTo[K]).
+type weightedMatcher struct {
+	nvertex        int
+	maxCardinality bool
+
+	edgeFrom   []int
+	edgeTo     []int
+	edgeWeight []float64
+
+	// endpoint[p] is the vertex to which endpoint p is attached
+	endpoint []int
+
+	// neighbend[v] is the list of remote endpoints of edges
+	// attached to vertex v
+	neighbend [][]int
+
+	// mate[v] is the remote endpoint of the matched edge of
+	// vertex v, or -1 if v is single
+	mate []int
+
+	// label[b] is 0 for unlabeled, 1 for S-vertex/blossom, 2 for
+	// T-vertex/blossom. Bit 4 is used temporarily by scanBlossom.
+	label []int
+
+	// labelend[b] is the remote endpoint of the edge through
+	// which b obtained its label, or -1
+	labelend []int
+
+	inblossom        []int
+	blossomparent    []int
+	blossomchilds    [][]int
+	blossombase      []int
+	blossomendps     [][]int
+	bestedge         []int
+	blossombestedges [][]int
+	unusedblossoms   []int
+	dualvar          []float64
+	allowedge        []bool
+	queue            []int
+}
+
+// newWeightedMatcher creates a new matcher for a graph with the given
+// number of vertices and edges.
+func newWeightedMatcher(nvertex, nedge int, maxCardinality bool) *weightedMatcher {
+	m := &weightedMatcher{
+		nvertex:          nvertex,
+		maxCardinality:   maxCardinality,
+		edgeFrom:         make([]int, nedge),
+		edgeTo:           make([]int, nedge),
+		edgeWeight:       make([]float64, nedge),
+		endpoint:         make([]int, 2*nedge),
+		neighbend:        make([][]int, nvertex),
+		mate:             make([]int, nvertex),
+		label:            make([]int, 2*nvertex),
+		labelend:         make([]int, 2*nvertex),
+		inblossom:        make([]int, nvertex),
+		blossomparent:    make([]int, 2*nvertex),
+		blossomchilds:    make([][]int, 2*nvertex),
+		blossombase:      make([]int, 2*nvertex),
+		blossomendps:     make([][]int, 2*nvertex),
+		bestedge:         make([]int, 2*nvertex),
+		blossombestedges: make([][]int, 2*nvertex),
+		unusedblossoms:   make([]int, 0, nvertex),
+		dualvar:          make([]float64, 2*nvertex),
+		allowedge:        make([]bool, nedge),
+		queue:            make([]int, 0),
+	}
+
+	for v := 0; v < nvertex; v++ {
+		m.mate[v] = -1
+		m.inblossom[v] = v
+		m.blossombase[v] = v
+		m.blossombase[nvertex+v] = -1
+		m.unusedblossoms = append(m.unusedblossoms, nvertex+v)
+	}
+	for b := 0; b < 2*nvertex; b++ {
+		m.labelend[b] = -1
+		m.blossomparent[b] = -1
+		m.bestedge[b] = -1
+	}
+
+	return m
+}
+
+// addEdge registers edge K connecting vertices I and J
+func (m *weightedMatcher) addEdge(k, i, j int, weight float64) {
+	m.edgeFrom[k] = i
+	m.edgeTo[k] = j
+	m.edgeWeight[k] = weight
+	m.endpoint[2*k] = i
+	m.endpoint[2*k+1] = j
+	m.neighbend[i] = append(m.neighbend[i], 2*k+1)
+	m.neighbend[j] = append(m.neighbend[j], 2*k)
+}
+
+// wrap converts a possibly negative index into a valid index for a
+// slice of length n, following the cyclic ordering of blossoms.
+func wrap(i, n int) int {
+	return ((i % n) + n) % n
+}
+
+// slack returns the slack of edge K
+func (m *weightedMatcher) slack(k int) float64 {
+	return m.dualvar[m.edgeFrom[k]] + m.dualvar[m.edgeTo[k]] - 2*m.edgeWeight[k]
+}
+
+// blossomLeaves returns the vertices contained in blossom B
+func (m *weightedMatcher) blossomLeaves(b int) []int {
+	if b < m.nvertex {
+		return []int{b}
+	}
+
+	leaves := make([]int, 0)
+	for _, t := range m.blossomchilds[b] {
+		leaves = append(leaves, m.blossomLeaves(t)...)
+	}
+
+	return leaves
+}
+
+// assignLabel assigns label T to the top-level blossom containing
+// vertex W, which is reached through the edge with remote endpoint P.
+func (m *weightedMatcher) assignLabel(w, t, p int) {
+	b := m.inblossom[w]
+	m.label[w] = t
+	m.label[b] = t
+	m.labelend[w] = p
+	m.labelend[b] = p
+	m.bestedge[w] = -1
+	m.bestedge[b] = -1
+
+	switch t {
+	case 1:
+		// B became an S-blossom, add its vertices to the queue
+		m.queue = append(m.queue, m.blossomLeaves(b)...)
+	case 2:
+		// B became a T-blossom, label its mate as S-blossom
+		base := m.blossombase[b]
+		m.assignLabel(m.endpoint[m.mate[base]], 1, m.mate[base]^1)
+	}
+}
+
+// scanBlossom traces back from vertices V and W to discover either a
+// new blossom or an augmenting path. Returns the base vertex of the
+// new blossom, or -1 when an augmenting path was found.
+func (m *weightedMatcher) scanBlossom(v, w int) int {
+	path := make([]int, 0)
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inblossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.blossombase[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelend[b] == -1 {
+			// Reached the root of the alternating tree
+			v = -1
+		} else {
+			v = m.endpoint[m.labelend[b]]
+			b = m.inblossom[v]
+			v = m.endpoint[m.labelend[b]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+
+	for _, b := range path {
+		m.label[b] = 1
+	}
+
+	return base
+}
+
+// addBlossom constructs a new blossom with the given base, containing
+// edge K which connects a pair of S-vertices.
+func (m *weightedMatcher) addBlossom(base, k int) {
+	v, w := m.edgeFrom[k], m.edgeTo[k]
+	bb := m.inblossom[base]
+	bv := m.inblossom[v]
+	bw := m.inblossom[w]
+
+	b := m.unusedblossoms[len(m.unusedblossoms)-1]
+	m.unusedblossoms = m.unusedblossoms[:len(m.unusedblossoms)-1]
+	m.blossombase[b] = base
+	m.blossomparent[b] = -1
+	m.blossomparent[bb] = b
+
+	path := make([]int, 0)
+	endps := make([]int, 0)
+	for bv != bb {
+		m.blossomparent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelend[bv])
+		v = m.endpoint[m.labelend[bv]]
+		bv = m.inblossom[v]
+	}
+	path = append(path, bb)
+	slices.Reverse(path)
+	slices.Reverse(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		m.blossomparent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelend[bw]^1)
+		w = m.endpoint[m.labelend[bw]]
+		bw = m.inblossom[w]
+	}
+	m.blossomchilds[b] = path
+	m.blossomendps[b] = endps
+
+	m.label[b] = 1
+	m.labelend[b] = m.labelend[bb]
+	m.dualvar[b] = 0.0
+
+	for _, v := range m.blossomLeaves(b) {
+		if m.label[m.inblossom[v]] == 2 {
+			// This T-vertex now turns into an S-vertex
+			m.queue = append(m.queue, v)
+		}
+		m.inblossom[v] = b
+	}
+
+	// Compute the least-slack edges to neighbouring S-blossoms
+	bestedgeto := make([]int, 2*m.nvertex)
+	for i := range bestedgeto {
+		bestedgeto[i] = -1
+	}
+	for _, bv := range path {
+		var nblists [][]int
+		if m.blossombestedges[bv] == nil {
+			for _, v := range m.blossomLeaves(bv) {
+				nblist := make([]int, 0, len(m.neighbend[v]))
+				for _, p := range m.neighbend[v] {
+					nblist = append(nblist, p/2)
+				}
+				nblists = append(nblists, nblist)
+			}
+		} else {
+			nblists = [][]int{m.blossombestedges[bv]}
+		}
+
+		for _, nblist := range nblists {
+			for _, k := range nblist {
+				j := m.edgeTo[k]
+				if m.inblossom[j] == b {
+					j = m.edgeFrom[k]
+				}
+				bj := m.inblossom[j]
+				if bj != b && m.label[bj] == 1 && (bestedgeto[bj] == -1 || m.slack(k) < m.slack(bestedgeto[bj])) {
+					bestedgeto[bj] = k
+				}
+			}
+		}
+		m.blossombestedges[bv] = nil
+		m.bestedge[bv] = -1
+	}
+
+	best := make([]int, 0)
+	for _, k := range bestedgeto {
+		if k != -1 {
+			best = append(best, k)
+		}
+	}
+	m.blossombestedges[b] = best
+	m.bestedge[b] = -1
+	for _, k := range best {
+		if m.bestedge[b] == -1 || m.slack(k) < m.slack(m.bestedge[b]) {
+			m.bestedge[b] = k
+		}
+	}
+}
+
+// expandBlossom expands the given blossom
+func (m *weightedMatcher) expandBlossom(b int, endstage bool) {
+	// Convert sub-blossoms into top-level blossoms
+	for _, s := range m.blossomchilds[b] {
+		m.blossomparent[s] = -1
+		if s < m.nvertex {
+			m.inblossom[s] = s
+		} else if endstage && m.dualvar[s] == 0 {
+			// Recursively expand this sub-blossom
+			m.expandBlossom(s, endstage)
+		} else {
+			for _, v := range m.blossomLeaves(s) {
+				m.inblossom[v] = s
+			}
+		}
+	}
+
+	// If we expand a T-blossom during a stage, its sub-blossoms
+	// must be relabeled.
+	if !endstage && m.label[b] == 2 {
+		childs := m.blossomchilds[b]
+		endps := m.blossomendps[b]
+		l := len(childs)
+
+		entrychild := m.inblossom[m.endpoint[m.labelend[b]^1]]
+		j := slices.Index(childs, entrychild)
+		var jstep, endptrick int
+		if j&1 != 0 {
+			// Start index is odd, go forward and wrap
+			j -= l
+			jstep = 1
+			endptrick = 0
+		} else {
+			// Start index is even, go backward
+			jstep = -1
+			endptrick = 1
+		}
+
+		// Move along the blossom until we get to the base
+		p := m.labelend[b]
+		for j != 0 {
+			// Relabel the T-sub-blossom
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[endps[wrap(j-endptrick, l)]^endptrick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+
+			// Step to the next S-sub-blossom and note its
+			// forward endpoint
+			m.allowedge[endps[wrap(j-endptrick, l)]/2] = true
+			j += jstep
+			p = endps[wrap(j-endptrick, l)] ^ endptrick
+
+			// Step to the next T-sub-blossom
+			m.allowedge[p/2] = true
+			j += jstep
+		}
+
+		// Relabel the base T-sub-blossom without creating a
+		// new S-blossom
+		bv := childs[wrap(j, l)]
+		m.label[m.endpoint[p^1]] = 2
+		m.label[bv] = 2
+		m.labelend[m.endpoint[p^1]] = p
+		m.labelend[bv] = p
+		m.bestedge[bv] = -1
+
+		// Continue along the blossom until we get back to
+		// the entry child
+		j += jstep
+		for childs[wrap(j, l)] != entrychild {
+			bv := childs[wrap(j, l)]
+			if m.label[bv] == 1 {
+				// This sub-blossom just got label S
+				// through one of its neighbours
+				j += jstep
+				continue
+			}
+
+			labeled := -1
+			for _, v := range m.blossomLeaves(bv) {
+				if m.label[v] != 0 {
+					labeled = v
+					break
+				}
+			}
+
+			// If the sub-blossom contains a reachable
+			// vertex, assign label T to the sub-blossom.
+			if labeled != -1 {
+				m.label[labeled] = 0
+				m.label[m.endpoint[m.mate[m.blossombase[bv]]]] = 0
+				m.assignLabel(labeled, 2, m.labelend[labeled])
+			}
+			j += jstep
+		}
+	}
+
+	// Recycle the blossom number
+	m.label[b] = -1
+	m.labelend[b] = -1
+	m.blossomchilds[b] = nil
+	m.blossomendps[b] = nil
+	m.blossombase[b] = -1
+	m.blossombestedges[b] = nil
+	m.bestedge[b] = -1
+	m.unusedblossoms = append(m.unusedblossoms, b)
+}
+
+// augmentBlossom swaps matched/unmatched edges over an alternating
+// path through blossom B between vertex V and the base vertex.
+func (m *weightedMatcher) augmentBlossom(b, v int) {
+	// Bubble up through the blossom tree from V to an immediate
+	// sub-blossom of B
+	t := v
+	for m.blossomparent[t] != b {
+		t = m.blossomparent[t]
+	}
+
+	// Recursively deal with the first sub-blossom
+	if t >= m.nvertex {
+		m.augmentBlossom(t, v)
+	}
+
+	childs := m.blossomchilds[b]
+	endps := m.blossomendps[b]
+	l := len(childs)
+
+	i := slices.Index(childs, t)
+	j := i
+	var jstep, endptrick int
+	if i&1 != 0 {
+		j -= l
+		jstep = 1
+		endptrick = 0
+	} else {
+		jstep = -1
+		endptrick = 1
+	}
+
+	// Move along the blossom until we get to the base
+	for j != 0 {
+		// Step to the next sub-blossom and augment it
+		// recursively if necessary
+		j += jstep
+		t = childs[wrap(j, l)]
+		p := endps[wrap(j-endptrick, l)] ^ endptrick
+		if t >= m.nvertex {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+
+		// Step to the next sub-blossom and augment it
+		// recursively if necessary
+		j += jstep
+		t = childs[wrap(j, l)]
+		if t >= m.nvertex {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+
+		// Match the edge connecting those sub-blossoms
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+
+	// Rotate the list of sub-blossoms to put the new base at the
+	// front
+	m.blossomchilds[b] = append(slices.Clone(childs[i:]), childs[:i]...)
+	m.blossomendps[b] = append(slices.Clone(endps[i:]), endps[:i]...)
+	m.blossombase[b] = m.blossombase[m.blossomchilds[b][0]]
+}
+
+// augmentMatching swaps matched/unmatched edges over an alternating
+// path between two single vertices, which goes through edge K.
+func (m *weightedMatcher) augmentMatching(k int) {
+	ends := [][2]int{
+		{m.edgeFrom[k], 2*k + 1},
+		{m.edgeTo[k], 2 * k},
+	}
+
+	for _, end := range ends {
+		s, p := end[0], end[1]
+		for {
+			bs := m.inblossom[s]
+			if bs >= m.nvertex {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+
+			// Stop when we reach a single vertex
+			if m.labelend[bs] == -1 {
+				break
+			}
+
+			t := m.endpoint[m.labelend[bs]]
+			bt := m.inblossom[t]
+			s = m.endpoint[m.labelend[bt]]
+			j := m.endpoint[m.labelend[bt]^1]
+			if bt >= m.nvertex {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelend[bt]
+			p = m.labelend[bt] ^ 1
+		}
+	}
+}
+
+// solve computes the matching and returns the mate array, where
+// mate[v] is the remote endpoint of the matched edge of vertex v, or
+// -1 if v is single.
+func (m *weightedMatcher) solve() []int {
+	n := m.nvertex
+
+	// Initialize the vertex duals to the maximum edge weight
+	maxWeight := 0.0
+	for _, w := range m.edgeWeight {
+		maxWeight = max(maxWeight, w)
+	}
+	for v := 0; v < n; v++ {
+		m.dualvar[v] = maxWeight
+	}
+
+	// Each iteration of this loop is a stage, which either
+	// augments the matching or terminates the algorithm.
+	for stage := 0; stage < n; stage++ {
+		for b := 0; b < 2*n; b++ {
+			m.label[b] = 0
+			m.bestedge[b] = -1
+		}
+		for b := n; b < 2*n; b++ {
+			m.blossombestedges[b] = nil
+		}
+		for k := range m.allowedge {
+			m.allowedge[k] = false
+		}
+		m.queue = m.queue[:0]
+
+		// Label single top-level blossoms with S
+		for v := 0; v < n; v++ {
+			if m.mate[v] == -1 && m.label[m.inblossom[v]] == 0 {
+				m.assignLabel(v, 1, -1)
+			}
+		}
+
+		augmented := false
+		for {
+			// Continue labeling until all vertices, which
+			// are reachable through an alternating path
+			// have got a label.
+			for len(m.queue) > 0 && !augmented {
+				v := m.queue[len(m.queue)-1]
+				m.queue = m.queue[:len(m.queue)-1]
+
+				for _, p := range m.neighbend[v] {
+					k := p / 2
+					w := m.endpoint[p]
+					if m.inblossom[v] == m.inblossom[w] {
+						// Edge internal to a blossom
+						continue
+					}
+
+					var kslack float64
+					if !m.allowedge[k] {
+						kslack = m.slack(k)
+						if kslack <= 0 {
+							m.allowedge[k] = true
+						}
+					}
+
+					if m.allowedge[k] {
+						if m.label[m.inblossom[w]] == 0 {
+							// W is a free vertex, label
+							// it with T and its mate with S
+							m.assignLabel(w, 2, p^1)
+						} else if m.label[m.inblossom[w]] == 1 {
+							// W is an S-vertex, which
+							// means either a new blossom
+							// or an augmenting path
+							base := m.scanBlossom(v, w)
+							if base >= 0 {
+								m.addBlossom(base, k)
+							} else {
+								m.augmentMatching(k)
+								augmented = true
+								break
+							}
+						} else if m.label[w] == 0 {
+							// W is inside a T-blossom, but
+							// has not been reached yet
+							m.label[w] = 2
+							m.labelend[w] = p ^ 1
+						}
+					} else if m.label[m.inblossom[w]] == 1 {
+						// Keep track of the least-slack
+						// non-allowable edge to a different
+						// S-blossom
+						b := m.inblossom[v]
+						if m.bestedge[b] == -1 || kslack < m.slack(m.bestedge[b]) {
+							m.bestedge[b] = k
+						}
+					} else if m.label[w] == 0 {
+						// W is a free vertex, or an
+						// unreached vertex inside a
+						// T-blossom
+						if m.bestedge[w] == -1 || kslack < m.slack(m.bestedge[w]) {
+							m.bestedge[w] = k
+						}
+					}
+				}
+			}
+
+			if augmented {
+				break
+			}
+
+			// No further progress is possible without a
+			// dual variable update, compute delta.
+			deltatype := -1
+			delta := 0.0
+			deltaedge := -1
+			deltablossom := -1
+
+			// Delta1: the minimum value of any vertex dual
+			if !m.maxCardinality {
+				deltatype = 1
+				delta = slices.Min(m.dualvar[:n])
+			}
+
+			// Delta2: the minimum slack on any edge between
+			// an S-vertex and a free vertex
+			for v := 0; v < n; v++ {
+				if m.label[m.inblossom[v]] == 0 && m.bestedge[v] != -1 {
+					d := m.slack(m.bestedge[v])
+					if deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 2
+						deltaedge = m.bestedge[v]
+					}
+				}
+			}
+
+			// Delta3: half the minimum slack on any edge
+			// between a pair of S-blossoms
+			for b := 0; b < 2*n; b++ {
+				if m.blossomparent[b] == -1 && m.label[b] == 1 && m.bestedge[b] != -1 {
+					d := m.slack(m.bestedge[b]) / 2
+					if deltatype == -1 || d < delta {
+						delta = d
+						deltatype = 3
+						deltaedge = m.bestedge[b]
+					}
+				}
+			}
+
+			// Delta4: the minimum z variable of any T-blossom
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 && m.label[b] == 2 && (deltatype == -1 || m.dualvar[b] < delta) {
+					delta = m.dualvar[b]
+					deltatype = 4
+					deltablossom = b
+				}
+			}
+
+			if deltatype == -1 {
+				// No further improvement is possible
+				// when looking for maximum cardinality,
+				// perform a final delta update.
+				deltatype = 1
+				delta = max(0, slices.Min(m.dualvar[:n]))
+			}
+
+			// Update the dual variables according to delta
+			for v := 0; v < n; v++ {
+				switch m.label[m.inblossom[v]] {
+				case 1:
+					m.dualvar[v] -= delta
+				case 2:
+					m.dualvar[v] += delta
+				}
+			}
+			for b := n; b < 2*n; b++ {
+				if m.blossombase[b] >= 0 && m.blossomparent[b] == -1 {
+					switch m.label[b] {
+					case 1:
+						m.dualvar[b] += delta
+					case 2:
+						m.dualvar[b] -= delta
+					}
+				}
+			}
+
+			// Take action at the point where the minimum
+			// delta occurred
+			if deltatype == 1 {
+				// No further improvement possible
+				break
+			} else if deltatype == 2 {
+				// Use the least-slack edge to continue
+				// the search
+				m.allowedge[deltaedge] = true
+				i, j := m.edgeFrom[deltaedge], m.edgeTo[deltaedge]
+				if m.label[m.inblossom[i]] == 0 {
+					i = j
+				}
+				m.queue = append(m.queue, i)
+			} else if deltatype == 3 {
+				// Use the least-slack edge to continue
+				// the search
+				m.allowedge[deltaedge] = true
+				m.queue = append(m.queue, m.edgeFrom[deltaedge])
+			} else if deltatype == 4 {
+				// Expand the least-z blossom
+				m.expandBlossom(deltablossom, false)
+			}
+		}
+
+		// Stop when no more augmenting paths can be found
+		if !augmented {
+			break
+		}
+
+		// End of stage, expand all S-blossoms which have
+		// zero dual
+		for b := n; b < 2*n; b++ {
+			if m.blossomparent[b] == -1 && m.blossombase[b] >= 0 && m.label[b] == 1 && m.dualvar[b] == 0 {
+				m.expandBlossom(b, true)
+			}
+		}
+	}
+
+	return m.mate
+}
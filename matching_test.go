@@ -0,0 +1,230 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// Creates a new random undirected graph with the given number of
+// vertices. Each possible edge is added with the given probability,
+// and gets a random integer weight.
+func newRandomUndirectedGraph(r *rand.Rand, n int, p float64) graph.Graph[int] {
+	g := graph.New[int](graph.KindUndirected)
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r.Float64() < p {
+				g.AddWeightedEdge(i, j, float64(r.Intn(20)+1))
+			}
+		}
+	}
+
+	return g
+}
+
+// A helper function which verifies that the given edges form a valid
+// matching in the graph
+func verifyMatching[T comparable](t *testing.T, g graph.Graph[T], matching []*graph.Edge[T]) {
+	matched := make(map[T]bool)
+	for _, e := range matching {
+		if !g.EdgeExists(e.From, e.To) {
+			t.Fatalf("edge %v-%v is not in the graph", e.From, e.To)
+		}
+		if matched[e.From] || matched[e.To] {
+			t.Fatalf("edge %v-%v shares a vertex with another matched edge", e.From, e.To)
+		}
+		matched[e.From] = true
+		matched[e.To] = true
+	}
+}
+
+// Computes the maximum cardinality, the maximum weight, and the
+// maximum weight among maximum cardinality matchings by exhaustive
+// search
+func bruteForceMatching(edges []*graph.Edge[int]) (int, float64, float64) {
+	bestSize := 0
+	bestWeight := 0.0
+	bestWeightMaxSize := 0.0
+	used := make(map[int]bool)
+
+	var search func(k, size int, weight float64)
+	search = func(k, size int, weight float64) {
+		if k == len(edges) {
+			bestWeight = max(bestWeight, weight)
+			if size > bestSize {
+				bestSize = size
+				bestWeightMaxSize = weight
+			} else if size == bestSize {
+				bestWeightMaxSize = max(bestWeightMaxSize, weight)
+			}
+			return
+		}
+
+		// Skip the edge
+		search(k+1, size, weight)
+
+		// Take the edge, if possible
+		e := edges[k]
+		if !used[e.From] && !used[e.To] {
+			used[e.From] = true
+			used[e.To] = true
+			search(k+1, size+1, weight+e.Weight)
+			used[e.From] = false
+			used[e.To] = false
+		}
+	}
+	search(0, 0, 0.0)
+
+	return bestSize, bestWeight, bestWeightMaxSize
+}
+
+func matchingWeight[T comparable](matching []*graph.Edge[T]) float64 {
+	total := 0.0
+	for _, e := range matching {
+		total += e.Weight
+	}
+
+	return total
+}
+
+func TestMaxMatching(t *testing.T) {
+	// Matching is not supported on directed graphs
+	if _, err := graph.MaxMatching(newDirectedGraph()); err != graph.ErrIsNotUndirectedGraph {
+		t.Fatal("MaxMatching: should fail on directed graphs")
+	}
+
+	// A triangle with a pendant path requires contracting a
+	// blossom in order to find the perfect matching
+	g := graph.New[int](graph.KindUndirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+
+	matching, err := graph.MaxMatching(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyMatching(t, g, matching)
+	if len(matching) != 3 {
+		t.Fatalf("want matching of size 3, got %d", len(matching))
+	}
+
+	// Empty graph
+	empty := graph.New[int](graph.KindUndirected)
+	matching, err = graph.MaxMatching(empty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matching) != 0 {
+		t.Fatal("empty graph must have an empty matching")
+	}
+
+	// Cross-check against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		g := newRandomUndirectedGraph(r, r.Intn(9)+1, r.Float64())
+		matching, err := graph.MaxMatching(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyMatching(t, g, matching)
+
+		wantSize, _, _ := bruteForceMatching(g.GetEdges())
+		if len(matching) != wantSize {
+			t.Fatalf("want matching of size %d, got %d", wantSize, len(matching))
+		}
+	}
+}
+
+func TestMaxWeightMatching(t *testing.T) {
+	// Matching is not supported on directed graphs
+	if _, err := graph.MaxWeightMatching(newDirectedGraph(), false); err != graph.ErrIsNotUndirectedGraph {
+		t.Fatal("MaxWeightMatching: should fail on directed graphs")
+	}
+
+	// The heavy middle edge wins unless we ask for maximum
+	// cardinality
+	g := graph.New[int](graph.KindUndirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 3)
+	g.AddWeightedEdge(3, 4, 1)
+
+	matching, err := graph.MaxWeightMatching(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyMatching(t, g, matching)
+	if len(matching) != 1 || matchingWeight(matching) != 3 {
+		t.Fatalf("want a single edge of weight 3, got %d edges of weight %.2f", len(matching), matchingWeight(matching))
+	}
+
+	matching, err = graph.MaxWeightMatching(g, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyMatching(t, g, matching)
+	if len(matching) != 2 || matchingWeight(matching) != 2 {
+		t.Fatalf("want two edges of weight 2, got %d edges of weight %.2f", len(matching), matchingWeight(matching))
+	}
+
+	// Cross-check against brute force on random graphs
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 300; i++ {
+		g := newRandomUndirectedGraph(r, r.Intn(10)+1, r.Float64())
+		wantSize, wantWeight, wantWeightMaxSize := bruteForceMatching(g.GetEdges())
+
+		matching, err := graph.MaxWeightMatching(g, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyMatching(t, g, matching)
+		if got := matchingWeight(matching); got != wantWeight {
+			t.Fatalf("want maximum weight %.2f, got %.2f", wantWeight, got)
+		}
+
+		matching, err = graph.MaxWeightMatching(g, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyMatching(t, g, matching)
+		if len(matching) != wantSize {
+			t.Fatalf("want matching of size %d, got %d", wantSize, len(matching))
+		}
+		if got := matchingWeight(matching); got != wantWeightMaxSize {
+			t.Fatalf("want maximum cardinality weight %.2f, got %.2f", wantWeightMaxSize, got)
+		}
+	}
+}
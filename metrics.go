@@ -0,0 +1,316 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNotConnected is returned whenever an operation cannot be
+// performed, because the graph is not connected. Directed graphs are
+// required to be strongly connected.
+var ErrNotConnected = errors.New("graph is not connected")
+
+// distanceSummary contains the eccentricity and the sum of distances
+// of each vertex, in terms of vertex positions.
+type distanceSummary[T comparable] struct {
+	idx          *vertexIndex[T]
+	eccentricity []float64
+	totals       []float64
+}
+
+// summarizeDistances computes the shortest paths from each vertex in
+// the graph using BFS, or Dijkstra's algorithm when weighted is true.
+// Returns ErrNotConnected, if some vertex is unreachable from another.
+func summarizeDistances[T comparable](g Graph[T], weighted bool) (*distanceSummary[T], error) {
+	if weighted {
+		if err := validateWeights(g); err != nil {
+			return nil, err
+		}
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+	summary := &distanceSummary[T]{
+		idx:          idx,
+		eccentricity: make([]float64, n),
+		totals:       make([]float64, n),
+	}
+
+	// Each source only updates its own position, so all
+	// goroutines can safely share the same summary.
+	newAcc := func() *distanceSummary[T] {
+		return summary
+	}
+	compute := func(acc *distanceSummary[T], source int) {
+		sp := singleSourceShortestPaths(arcs, source, weighted)
+		for _, d := range sp.dist {
+			acc.eccentricity[source] = max(acc.eccentricity[source], d)
+			acc.totals[source] += d
+		}
+	}
+	forEachSource(n, 0, newAcc, compute)
+
+	for _, e := range summary.eccentricity {
+		if math.IsInf(e, 1) {
+			return nil, ErrNotConnected
+		}
+	}
+
+	return summary, nil
+}
+
+// Eccentricity returns the eccentricity of each vertex in the graph,
+// which is the maximum distance from the vertex to any other vertex.
+// Distances are computed by counting the number of hops, or by using
+// the edge weights when weighted is true.
+//
+// Returns ErrNotConnected, if the graph is not connected.
+func Eccentricity[T comparable](g Graph[T], weighted bool) (map[T]float64, error) {
+	summary, err := summarizeDistances(g, weighted)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[T]float64, len(summary.idx.values))
+	for i, v := range summary.idx.values {
+		result[v] = summary.eccentricity[i]
+	}
+
+	return result, nil
+}
+
+// Diameter returns the diameter of the graph, which is the maximum
+// eccentricity of its vertices.
+func Diameter[T comparable](g Graph[T], weighted bool) (float64, error) {
+	summary, err := summarizeDistances(g, weighted)
+	if err != nil {
+		return 0, err
+	}
+
+	diameter := 0.0
+	for _, e := range summary.eccentricity {
+		diameter = max(diameter, e)
+	}
+
+	return diameter, nil
+}
+
+// Radius returns the radius of the graph, which is the minimum
+// eccentricity of its vertices.
+func Radius[T comparable](g Graph[T], weighted bool) (float64, error) {
+	summary, err := summarizeDistances(g, weighted)
+	if err != nil {
+		return 0, err
+	}
+
+	radius := math.Inf(1)
+	for _, e := range summary.eccentricity {
+		radius = min(radius, e)
+	}
+	if math.IsInf(radius, 1) {
+		return 0, nil
+	}
+
+	return radius, nil
+}
+
+// Center returns the vertices, whose eccentricity is equal to the
+// radius of the graph.
+func Center[T comparable](g Graph[T], weighted bool) ([]T, error) {
+	return verticesWithEccentricity(g, weighted, func(e, radius, diameter float64) bool {
+		return e == radius
+	})
+}
+
+// Periphery returns the vertices, whose eccentricity is equal to the
+// diameter of the graph.
+func Periphery[T comparable](g Graph[T], weighted bool) ([]T, error) {
+	return verticesWithEccentricity(g, weighted, func(e, radius, diameter float64) bool {
+		return e == diameter
+	})
+}
+
+// verticesWithEccentricity returns the vertices, whose eccentricity
+// satisfies the given predicate
+func verticesWithEccentricity[T comparable](g Graph[T], weighted bool, predicate func(e, radius, diameter float64) bool) ([]T, error) {
+	summary, err := summarizeDistances(g, weighted)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := math.Inf(1)
+	diameter := 0.0
+	for _, e := range summary.eccentricity {
+		radius = min(radius, e)
+		diameter = max(diameter, e)
+	}
+
+	result := make([]T, 0)
+	for i, v := range summary.idx.values {
+		if predicate(summary.eccentricity[i], radius, diameter) {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
+
+// AverageShortestPathLength returns the average length of the
+// shortest paths between all ordered pairs of distinct vertices.
+//
+// Returns ErrNotConnected, if the graph is not connected.
+func AverageShortestPathLength[T comparable](g Graph[T], weighted bool) (float64, error) {
+	summary, err := summarizeDistances(g, weighted)
+	if err != nil {
+		return 0, err
+	}
+
+	n := len(summary.idx.values)
+	if n < 2 {
+		return 0, nil
+	}
+
+	total := 0.0
+	for _, t := range summary.totals {
+		total += t
+	}
+
+	return total / float64(n*(n-1)), nil
+}
+
+// Density returns the density of the graph, which is the ratio
+// between the number of edges and the maximum possible number of
+// edges.
+func Density[T comparable](g Graph[T]) float64 {
+	n := float64(len(g.GetVertices()))
+	m := float64(len(g.GetEdges()))
+	if n < 2 {
+		return 0.0
+	}
+
+	if g.Kind() == KindUndirected {
+		return 2 * m / (n * (n - 1))
+	}
+
+	return m / (n * (n - 1))
+}
+
+// AverageDegree returns the average degree of the vertices in the
+// graph. For directed graphs the average out-degree is returned,
+// which is equal to the average in-degree.
+func AverageDegree[T comparable](g Graph[T]) float64 {
+	vertices := g.GetVertices()
+	if len(vertices) == 0 {
+		return 0.0
+	}
+
+	total := 0
+	for _, v := range vertices {
+		total += v.Degree.Out
+	}
+
+	return float64(total) / float64(len(vertices))
+}
+
+// DegreeHistogram returns a slice, where the item at index i contains
+// the number of vertices with degree i. For directed graphs the sum
+// of the in- and out-degree is used.
+func DegreeHistogram[T comparable](g Graph[T]) []int {
+	degree := func(v *Vertex[T]) int {
+		if g.Kind() == KindUndirected {
+			return v.Degree.Out
+		}
+		return v.Degree.In + v.Degree.Out
+	}
+
+	result := make([]int, 0)
+	for _, v := range g.GetVertices() {
+		d := degree(v)
+		for len(result) <= d {
+			result = append(result, 0)
+		}
+		result[d]++
+	}
+
+	return result
+}
+
+// DegreeAssortativity returns the degree assortativity coefficient of
+// the graph, which is the Pearson correlation coefficient of the
+// degrees of the vertices at both ends of each edge. For directed
+// graphs the out-degree of the source vertex is correlated with the
+// in-degree of the destination vertex.
+//
+// The result is NaN, if the coefficient is undefined, e.g. when all
+// vertices have the same degree.
+func DegreeAssortativity[T comparable](g Graph[T]) float64 {
+	xs := make([]float64, 0)
+	ys := make([]float64, 0)
+	for _, e := range g.GetEdges() {
+		from := g.GetVertex(e.From)
+		to := g.GetVertex(e.To)
+		if g.Kind() == KindUndirected {
+			xs = append(xs, float64(from.Degree.Out), float64(to.Degree.Out))
+			ys = append(ys, float64(to.Degree.Out), float64(from.Degree.Out))
+		} else {
+			xs = append(xs, float64(from.Degree.Out))
+			ys = append(ys, float64(to.Degree.In))
+		}
+	}
+
+	return pearsonCorrelation(xs, ys)
+}
+
+// pearsonCorrelation returns the Pearson correlation coefficient of
+// the given samples
+func pearsonCorrelation(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	if n == 0 {
+		return math.NaN()
+	}
+
+	meanX, meanY := 0.0, 0.0
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	cov, varX, varY := 0.0, 0.0, 0.0
+	for i := range xs {
+		dx := xs[i] - meanX
+		dy := ys[i] - meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+
+	return cov / math.Sqrt(varX*varY)
+}
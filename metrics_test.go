@@ -0,0 +1,191 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+func TestEccentricity(t *testing.T) {
+	g := newPathGraph()
+	got, err := graph.Eccentricity(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 4, 2: 3, 3: 2, 4: 3, 5: 4}, got, 1.0e-9)
+
+	diameter, err := graph.Diameter(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diameter != 4 {
+		t.Fatalf("want diameter 4, got %.2f", diameter)
+	}
+
+	radius, err := graph.Radius(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if radius != 2 {
+		t.Fatalf("want radius 2, got %.2f", radius)
+	}
+
+	center, err := graph.Center(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(center, []int{3}) {
+		t.Fatalf("want center [3], got %v", center)
+	}
+
+	periphery, err := graph.Periphery(g, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(periphery)
+	if !slices.Equal(periphery, []int{1, 5}) {
+		t.Fatalf("want periphery [1 5], got %v", periphery)
+	}
+
+	// Weighted graph, where the direct edge is longer than the
+	// path going through the middle vertex
+	w := graph.New[int](graph.KindUndirected)
+	w.AddWeightedEdge(1, 2, 1)
+	w.AddWeightedEdge(2, 3, 1)
+	w.AddWeightedEdge(1, 3, 5)
+	got, err = graph.Eccentricity(w, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{1: 2, 2: 1, 3: 2}, got, 1.0e-9)
+
+	// Disconnected graphs
+	if _, err := graph.Eccentricity(newUndirectedWeightedGraph(), true); err != graph.ErrNotConnected {
+		t.Fatal("Eccentricity: should fail on disconnected graphs")
+	}
+
+	// Directed graphs must be strongly connected
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 3)
+	if _, err := graph.Diameter(d, false); err != graph.ErrNotConnected {
+		t.Fatal("Diameter: should fail on graphs which are not strongly connected")
+	}
+	d.AddEdge(3, 1)
+	diameter, err = graph.Diameter(d, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diameter != 2 {
+		t.Fatalf("want diameter 2, got %.2f", diameter)
+	}
+}
+
+func TestAverageShortestPathLength(t *testing.T) {
+	got, err := graph.AverageShortestPathLength(newPathGraph(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 2.0 {
+		t.Fatalf("want average shortest path length 2.0, got %.2f", got)
+	}
+
+	if _, err := graph.AverageShortestPathLength(newUndirectedGraph(), false); err != graph.ErrNotConnected {
+		t.Fatal("AverageShortestPathLength: should fail on disconnected graphs")
+	}
+}
+
+func TestDensity(t *testing.T) {
+	if got := graph.Density(newPathGraph()); math.Abs(got-0.4) > 1.0e-9 {
+		t.Fatalf("want density 0.4, got %.2f", got)
+	}
+
+	complete := graph.New[int](graph.KindUndirected)
+	for i := 0; i < 4; i++ {
+		for j := i + 1; j < 4; j++ {
+			complete.AddEdge(i, j)
+		}
+	}
+	if got := graph.Density(complete); got != 1.0 {
+		t.Fatalf("want density 1.0, got %.2f", got)
+	}
+
+	d := graph.New[int](graph.KindDirected)
+	d.AddEdge(1, 2)
+	if got := graph.Density(d); got != 0.5 {
+		t.Fatalf("want density 0.5, got %.2f", got)
+	}
+
+	if got := graph.Density(graph.New[int](graph.KindDirected)); got != 0 {
+		t.Fatalf("want density 0, got %.2f", got)
+	}
+}
+
+func TestAverageDegree(t *testing.T) {
+	if got := graph.AverageDegree(newPathGraph()); got != 1.6 {
+		t.Fatalf("want average degree 1.6, got %.2f", got)
+	}
+
+	if got := graph.AverageDegree(newDirectedGraph()); math.Abs(got-7.0/9) > 1.0e-9 {
+		t.Fatalf("want average degree %.2f, got %.2f", 7.0/9, got)
+	}
+}
+
+func TestDegreeHistogram(t *testing.T) {
+	if got := graph.DegreeHistogram(newPathGraph()); !slices.Equal(got, []int{0, 2, 3}) {
+		t.Fatalf("want degree histogram [0 2 3], got %v", got)
+	}
+
+	if got := graph.DegreeHistogram(newDirectedGraph()); !slices.Equal(got, []int{0, 5, 3, 1}) {
+		t.Fatalf("want degree histogram [0 5 3 1], got %v", got)
+	}
+}
+
+func TestDegreeAssortativity(t *testing.T) {
+	if got := graph.DegreeAssortativity(newPathGraph()); math.Abs(got+1.0/3) > 1.0e-9 {
+		t.Fatalf("want assortativity %.6f, got %.6f", -1.0/3, got)
+	}
+
+	star := graph.New[int](graph.KindUndirected)
+	star.AddEdge(0, 1)
+	star.AddEdge(0, 2)
+	star.AddEdge(0, 3)
+	if got := graph.DegreeAssortativity(star); math.Abs(got+1.0) > 1.0e-9 {
+		t.Fatalf("want assortativity -1.0, got %.6f", got)
+	}
+
+	cycle := graph.New[int](graph.KindUndirected)
+	cycle.AddEdge(1, 2)
+	cycle.AddEdge(2, 3)
+	cycle.AddEdge(3, 1)
+	if got := graph.DegreeAssortativity(cycle); !math.IsNaN(got) {
+		t.Fatalf("want undefined assortativity, got %.6f", got)
+	}
+}
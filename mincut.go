@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"gopkg.in/dnaeon/go-priorityqueue.v1"
+)
+
+// StoerWagnerMinCut returns a global minimum cut of the weighted
+// undirected graph, using the algorithm of Stoer and Wagner. A cut
+// splits the vertices into two non-empty parts, and its value is the
+// total weight of the edges between the parts. Returns the value of
+// the cut along with both parts. Edge weights must be non-negative,
+// and the graph must have at least two vertices.
+func StoerWagnerMinCut[T comparable](g Graph[T]) (float64, []T, []T, error) {
+	if g.Kind() != KindUndirected {
+		return 0, nil, nil, ErrIsNotUndirectedGraph
+	}
+	if err := validateWeights(g); err != nil {
+		return 0, nil, nil, err
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	if n < 2 {
+		return 0, nil, nil, fmt.Errorf("Graph must have at least two vertices, got %d", n)
+	}
+
+	// Weights between the merged vertices, and the original
+	// vertices merged into each one
+	adj := make([]map[int]float64, n)
+	members := make([][]int, n)
+	for v := range adj {
+		adj[v] = make(map[int]float64)
+		members[v] = []int{v}
+	}
+	for _, e := range g.GetEdges() {
+		from, to := idx.index[e.From], idx.index[e.To]
+		if from != to {
+			adj[from][to] += e.Weight
+			adj[to][from] += e.Weight
+		}
+	}
+
+	active := make([]int, n)
+	for v := range active {
+		active[v] = v
+	}
+
+	best := math.Inf(1)
+	var bestSide []int
+	for len(active) > 1 {
+		// Add the most tightly connected vertex to the set
+		// until all vertices are added
+		queue := priorityqueue.New[int, float64](priorityqueue.MaxHeap)
+		queued := make(map[int]float64, len(active))
+		for _, v := range active {
+			queue.Put(v, 0)
+			queued[v] = 0
+		}
+
+		var s, t int
+		cutOfPhase := 0.0
+		for !queue.IsEmpty() {
+			item := queue.Get()
+			s, t = t, item.Value
+			cutOfPhase = item.Priority
+			delete(queued, t)
+			for u, w := range adj[t] {
+				if key, ok := queued[u]; ok {
+					queued[u] = key + w
+					queue.Update(u, key+w)
+				}
+			}
+		}
+
+		if cutOfPhase < best {
+			best = cutOfPhase
+			bestSide = append([]int{}, members[t]...)
+		}
+
+		// Merge the last two vertices
+		for u, w := range adj[t] {
+			delete(adj[u], t)
+			if u != s {
+				adj[s][u] += w
+				adj[u][s] += w
+			}
+		}
+		members[s] = append(members[s], members[t]...)
+		for i, v := range active {
+			if v == t {
+				active = append(active[:i], active[i+1:]...)
+				break
+			}
+		}
+	}
+
+	inSide := make([]bool, n)
+	for _, v := range bestSide {
+		inSide[v] = true
+	}
+	side := make([]T, 0, len(bestSide))
+	rest := make([]T, 0, n-len(bestSide))
+	for v, value := range idx.values {
+		if inSide[v] {
+			side = append(side, value)
+		} else {
+			rest = append(rest, value)
+		}
+	}
+
+	return best, side, rest, nil
+}
+
+// GomoryHuTree returns a Gomory-Hu tree of the weighted undirected
+// graph, using the algorithm of Gusfield. The tree contains the
+// vertices of the graph, and the value of a minimum cut between any
+// two vertices equals the minimum weight of an edge along the path
+// between them in the tree, which can be found with GomoryHuMinCut.
+//
+// The tree is built with n-1 maximum flow computations. Edge weights
+// must be non-negative, and are used as capacities. Vertices in
+// different connected components are joined by edges of zero weight.
+func GomoryHuTree[T comparable](g Graph[T]) (Graph[T], error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+	if err := validateWeights(g); err != nil {
+		return nil, err
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	parent := make([]int, n)
+	value := make([]float64, n)
+
+	for s := 1; s < n; s++ {
+		network := newFlowNetwork(n)
+		for _, e := range g.GetEdges() {
+			from, to := idx.index[e.From], idx.index[e.To]
+			if from != to {
+				network.addArc(from, to, e.Weight, 0)
+				network.addArc(to, from, e.Weight, 0)
+			}
+		}
+
+		t := parent[s]
+		value[s] = network.maxFlow(s, t)
+
+		// Vertices on the side of S, which share its parent,
+		// are attached to S instead
+		side := network.residualReachable(s)
+		for v := s + 1; v < n; v++ {
+			if side[v] && parent[v] == t {
+				parent[v] = s
+			}
+		}
+	}
+
+	tree := New[T](KindUndirected)
+	for _, v := range idx.values {
+		tree.AddVertex(v)
+	}
+	for v := 1; v < n; v++ {
+		tree.AddWeightedEdge(idx.values[v], idx.values[parent[v]], value[v])
+	}
+
+	return tree, nil
+}
+
+// GomoryHuMinCut returns the value of a minimum cut between two
+// vertices, using a tree returned by GomoryHuTree. The value is the
+// minimum weight of an edge along the path between the vertices in
+// the tree.
+func GomoryHuMinCut[T comparable](tree Graph[T], u, v T) (float64, error) {
+	if !tree.VertexExists(v) {
+		return 0, fmt.Errorf("Destination vertex %v not found in the graph", v)
+	}
+	if u == v {
+		return 0, fmt.Errorf("Source and destination vertex %v must be different", u)
+	}
+
+	walkFunc := func(vertex *Vertex[T]) error {
+		if vertex.Value == v {
+			return ErrStopWalking
+		}
+		return nil
+	}
+	if err := WalkBFS(tree, u, walkFunc); err != nil {
+		return 0, err
+	}
+
+	result := math.Inf(1)
+	for x := tree.GetVertex(v); x.Parent != nil; x = x.Parent {
+		result = min(result, tree.GetEdge(x.Parent.Value, x.Value).Weight)
+	}
+	if math.IsInf(result, 1) {
+		return 0, fmt.Errorf("No path exists between %v and %v", u, v)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns the total weight of the edges
+// between the given side of a cut and the remaining vertices
+func cutValue[T comparable](g graph.Graph[T], side []T) float64 {
+	inSide := make(map[T]bool)
+	for _, v := range side {
+		inSide[v] = true
+	}
+
+	total := 0.0
+	for _, e := range g.GetEdges() {
+		if inSide[e.From] != inSide[e.To] {
+			total += e.Weight
+		}
+	}
+
+	return total
+}
+
+// A helper function which returns the minimum cut values between
+// each pair of vertices, and the global minimum cut value, by
+// checking each subset of the vertices
+func bruteForceMinCuts(g graph.Graph[int], n int) (map[[2]int]float64, float64) {
+	pairs := make(map[[2]int]float64)
+	global := math.Inf(1)
+	for mask := 1; mask < 1<<n-1; mask++ {
+		side := make([]int, 0)
+		for v := 0; v < n; v++ {
+			if mask&(1<<v) != 0 {
+				side = append(side, v)
+			}
+		}
+		value := cutValue(g, side)
+		global = min(global, value)
+		for u := 0; u < n; u++ {
+			for v := 0; v < n; v++ {
+				if mask&(1<<u) != 0 && mask&(1<<v) == 0 {
+					key := [2]int{min(u, v), max(u, v)}
+					if old, ok := pairs[key]; !ok || value < old {
+						pairs[key] = value
+					}
+				}
+			}
+		}
+	}
+
+	return pairs, global
+}
+
+func TestStoerWagnerMinCut(t *testing.T) {
+	// Two dense clusters joined by a weak link
+	g := graph.New[string](graph.KindUndirected)
+	g.AddWeightedEdge("a", "b", 5)
+	g.AddWeightedEdge("b", "c", 5)
+	g.AddWeightedEdge("c", "a", 5)
+	g.AddWeightedEdge("x", "y", 5)
+	g.AddWeightedEdge("y", "z", 5)
+	g.AddWeightedEdge("z", "x", 5)
+	g.AddWeightedEdge("c", "x", 2)
+	g.AddWeightedEdge("a", "z", 1)
+
+	value, side, rest, err := graph.StoerWagnerMinCut(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 3 || len(side) != 3 || len(rest) != 3 {
+		t.Fatalf("want cut of value 3 between the clusters, got %v: %v %v", value, side, rest)
+	}
+	if got := cutValue(g, side); got != value {
+		t.Fatalf("want cut value %v for %v, got %v", value, side, got)
+	}
+
+	if _, _, _, err := graph.StoerWagnerMinCut(graph.New[int](graph.KindDirected)); !errors.Is(err, graph.ErrIsNotUndirectedGraph) {
+		t.Fatalf("want ErrIsNotUndirectedGraph, got %v", err)
+	}
+	single := graph.New[int](graph.KindUndirected)
+	single.AddVertex(1)
+	if _, _, _, err := graph.StoerWagnerMinCut(single); err == nil {
+		t.Fatal("want error for a single vertex")
+	}
+}
+
+func TestMinCutRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(46))
+	for iter := 0; iter < 20; iter++ {
+		n := 8
+		g := newRandomUndirectedGraph(r, n, 0.4)
+		pairs, global := bruteForceMinCuts(g, n)
+
+		value, side, rest, err := graph.StoerWagnerMinCut(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != global {
+			t.Fatalf("want global min cut %v, got %v", global, value)
+		}
+		if len(side) == 0 || len(side)+len(rest) != n || cutValue(g, side) != value {
+			t.Fatalf("invalid cut %v %v of value %v", side, rest, value)
+		}
+
+		tree, err := graph.GomoryHuTree(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tree.GetVertices()) != n || len(tree.GetEdges()) != n-1 {
+			t.Fatalf("want tree with %d vertices, got %d vertices and %d edges", n, len(tree.GetVertices()), len(tree.GetEdges()))
+		}
+		for pair, want := range pairs {
+			got, err := graph.GomoryHuMinCut(tree, pair[0], pair[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Fatalf("want min cut %v between %v and %v, got %v", want, pair[0], pair[1], got)
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"slices"
+)
+
+// MinimumSpanningTree returns the minimum spanning tree of an
+// undirected graph using Kruskal's algorithm. If the graph is not
+// connected, the result is a minimum spanning forest, which spans
+// each connected component.
+//
+// The result is a new graph containing all vertices of the graph.
+// The weights and attributes of the vertices and edges are preserved.
+func MinimumSpanningTree[T comparable](g Graph[T]) (Graph[T], error) {
+	if g.Kind() != KindUndirected {
+		return nil, ErrIsNotUndirectedGraph
+	}
+
+	idx := newVertexIndex(g)
+	edges := slices.Clone(g.GetEdges())
+	slices.SortStableFunc(edges, func(a, b *Edge[T]) int {
+		if a.Weight < b.Weight {
+			return -1
+		}
+		if a.Weight > b.Weight {
+			return 1
+		}
+		return 0
+	})
+
+	ds := newDisjointSet(len(idx.values))
+	inTree := make(map[*Edge[T]]bool)
+	for _, e := range edges {
+		if ds.union(idx.index[e.From], idx.index[e.To]) {
+			inTree[e] = true
+		}
+	}
+
+	keepVertex := func(v T) bool {
+		return true
+	}
+	keepEdge := func(e *Edge[T]) bool {
+		return inTree[e]
+	}
+
+	return filteredSubgraph(g, keepVertex, keepEdge), nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns the total weight of the edges
+func totalWeight[T comparable](edges []*graph.Edge[T]) float64 {
+	total := 0.0
+	for _, e := range edges {
+		total += e.Weight
+	}
+
+	return total
+}
+
+func TestMinimumSpanningTree(t *testing.T) {
+	g := graph.New[string](graph.KindUndirected)
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("b", "c", 2)
+	g.AddWeightedEdge("b", "d", 5)
+	g.AddWeightedEdge("c", "d", 8)
+	g.AddWeightedEdge("d", "e", 3)
+	g.AddVertex("f")
+
+	tree, err := graph.MinimumSpanningTree(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tree.GetVertices()) != 6 {
+		t.Fatalf("want 6 vertices, got %d", len(tree.GetVertices()))
+	}
+	if len(tree.GetEdges()) != 4 {
+		t.Fatalf("want 4 edges, got %d", len(tree.GetEdges()))
+	}
+	if got := totalWeight(tree.GetEdges()); got != 11 {
+		t.Fatalf("want total weight 11, got %v", got)
+	}
+	for _, pair := range [][2]string{{"a", "c"}, {"b", "c"}, {"b", "d"}, {"d", "e"}} {
+		if !tree.EdgeExists(pair[0], pair[1]) {
+			t.Fatalf("want edge %v-%v in the tree", pair[0], pair[1])
+		}
+	}
+
+	if _, err := graph.MinimumSpanningTree(graph.New[int](graph.KindDirected)); !errors.Is(err, graph.ErrIsNotUndirectedGraph) {
+		t.Fatalf("want ErrIsNotUndirectedGraph, got %v", err)
+	}
+}
+
+// A helper function which returns the weight of a minimum spanning
+// forest by checking each subset of edges
+func bruteForceSpanningForest(g graph.Graph[int]) float64 {
+	n := len(g.GetVertices())
+	edges := g.GetEdges()
+
+	// forest returns the number of edges in a maximal forest of
+	// the subset, and whether the subset itself is a forest
+	forest := func(mask int) (int, bool) {
+		parent := make([]int, n)
+		for i := range parent {
+			parent[i] = i
+		}
+		var find func(int) int
+		find = func(v int) int {
+			if parent[v] != v {
+				parent[v] = find(parent[v])
+			}
+			return parent[v]
+		}
+
+		size := 0
+		acyclic := true
+		for i, e := range edges {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			u, v := find(e.From), find(e.To)
+			if u == v {
+				acyclic = false
+				continue
+			}
+			parent[u] = v
+			size++
+		}
+
+		return size, acyclic
+	}
+
+	full, _ := forest(1<<len(edges) - 1)
+	best := math.Inf(1)
+	for mask := 0; mask < 1<<len(edges); mask++ {
+		size, acyclic := forest(mask)
+		if !acyclic || size != full {
+			continue
+		}
+		weight := 0.0
+		for i, e := range edges {
+			if mask&(1<<i) != 0 {
+				weight += e.Weight
+			}
+		}
+		best = min(best, weight)
+	}
+
+	return best
+}
+
+func TestMinimumSpanningTreeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(36))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomUndirectedGraph(r, 6, 0.5)
+		tree, err := graph.MinimumSpanningTree(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, e := range tree.GetEdges() {
+			if !g.EdgeExists(e.From, e.To) {
+				t.Fatalf("edge %v-%v is not in the graph", e.From, e.To)
+			}
+		}
+		want := bruteForceSpanningForest(g)
+		if got := totalWeight(tree.GetEdges()); got != want {
+			t.Fatalf("want total weight %v, got %v", want, got)
+		}
+		if got := bruteForceSpanningForest(tree); got != want {
+			t.Fatalf("want spanning forest of weight %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"gopkg.in/dnaeon/go-deque.v1"
+	"gopkg.in/dnaeon/go-priorityqueue.v1"
+)
+
+// validateSources returns an error if any of the source vertices is
+// not part of the graph
+func validateSources[T comparable](g Graph[T], sources []T) error {
+	for _, source := range sources {
+		if !g.VertexExists(source) {
+			return fmt.Errorf("Source vertex %v not found in the graph", source)
+		}
+	}
+
+	return nil
+}
+
+// WalkBFSMulti performs Breadth-first Search (BFS) traversal of the
+// graph, starting from all of the given source vertices at once.
+//
+// Each visited vertex has its distance from the nearest source vertex
+// recorded in DistanceFromSource, and the nearest source vertex
+// itself recorded in NearestSource. Vertices which are equally close
+// to several source vertices are assigned to one of them.
+func WalkBFSMulti[T comparable](g Graph[T], sources []T, walkFunc WalkFunc[T]) error {
+	if err := validateSources(g, sources); err != nil {
+		return err
+	}
+
+	// Make sure to reset all vertex attributes
+	g.ResetVertexAttributes()
+
+	// Push the source vertices to the queue and paint them
+	queue := deque.New[*Vertex[T]]()
+	for _, source := range sources {
+		srcVertex := g.GetVertex(source)
+		if srcVertex.Color != White {
+			continue
+		}
+		srcVertex.Color = Gray
+		srcVertex.NearestSource = srcVertex
+		queue.PushBack(srcVertex)
+	}
+
+	for !queue.IsEmpty() {
+		// Pop an item from the queue
+		v, err := queue.PopFront()
+		if err != nil {
+			panic(err)
+		}
+
+		// Visit neighbours of V
+		neighbours := g.GetNeighbourVertices(v.Value)
+		for _, u := range neighbours {
+			// First time seeing this vertex
+			if u.Color == White {
+				u.Color = Gray
+				u.DistanceFromSource = v.DistanceFromSource + 1
+				u.Parent = v
+				u.NearestSource = v.NearestSource
+				queue.PushBack(u)
+			}
+		}
+
+		walkErr := walkFunc(v)
+		if walkErr == ErrStopWalking {
+			return nil
+		}
+		if walkErr != nil {
+			return walkErr
+		}
+
+		// We are done with V
+		v.Color = Black
+	}
+
+	return nil
+}
+
+// DijkstraMulti computes the shortest-path distance from the nearest
+// of the given source vertices to each vertex in the graph, using
+// Dijkstra's algorithm with all source vertices starting at distance
+// zero. It returns the distance of each reachable vertex along with
+// its nearest source vertex, which partitions the reachable vertices
+// into Voronoi cells around the sources. Vertices which are equally
+// close to several source vertices are assigned to one of them.
+//
+// The vertex attributes are updated as well, so that the Parent
+// relationships form a shortest-path forest rooted at the sources.
+// The edge weights must not be negative.
+func DijkstraMulti[T comparable](g Graph[T], sources []T) (map[T]float64, map[T]T, error) {
+	if err := validateSources(g, sources); err != nil {
+		return nil, nil, err
+	}
+
+	// Set tentative distance for all vertices
+	g.ResetVertexAttributes()
+	for _, v := range g.GetVertices() {
+		v.DistanceFromSource = math.Inf(1)
+	}
+
+	queue := priorityqueue.New[*Vertex[T], float64](priorityqueue.MinHeap)
+	for _, source := range sources {
+		srcVertex := g.GetVertex(source)
+		if srcVertex.Color != White {
+			continue
+		}
+		srcVertex.Color = Gray
+		srcVertex.DistanceFromSource = 0.0
+		srcVertex.NearestSource = srcVertex
+		queue.Put(srcVertex, 0.0)
+	}
+
+	dist := make(map[T]float64)
+	nearest := make(map[T]T)
+	for !queue.IsEmpty() {
+		v := queue.Get().Value
+		v.Color = Black
+		dist[v.Value] = v.DistanceFromSource
+		nearest[v.Value] = v.NearestSource.Value
+
+		// Relax edges connecting V and it's neighbours
+		for _, u := range g.GetNeighbourVertices(v.Value) {
+			if u.Color == Black {
+				continue
+			}
+			edge := g.GetEdge(v.Value, u.Value)
+			if edge.Weight < 0 {
+				return nil, nil, fmt.Errorf("Negative weight for edge %v-%v", edge.From, edge.To)
+			}
+			alt := v.DistanceFromSource + edge.Weight
+			if alt >= u.DistanceFromSource {
+				continue
+			}
+			u.DistanceFromSource = alt
+			u.Parent = v
+			u.NearestSource = v.NearestSource
+			if u.Color == Gray {
+				queue.Update(u, alt)
+			} else {
+				u.Color = Gray
+				queue.Put(u, alt)
+			}
+		}
+	}
+
+	return dist, nearest, nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which returns the distance from the nearest
+// source vertex to each reachable vertex, using the given walk
+// function from each source vertex in turn
+func nearestDistances(g graph.Graph[int], sources []int, walk func(graph.Graph[int], int, graph.WalkFunc[int]) error) map[int]float64 {
+	result := make(map[int]float64)
+	for _, source := range sources {
+		for v, d := range walkDistances(g, source, walk) {
+			if old, ok := result[v]; !ok || d < old {
+				result[v] = d
+			}
+		}
+	}
+
+	return result
+}
+
+func TestWalkBFSMulti(t *testing.T) {
+	g := newPathGraph()
+	collector := g.NewCollector()
+	if err := graph.WalkBFSMulti(g, []int{1, 5}, collector.WalkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if len(collector.Get()) != 5 {
+		t.Fatalf("want 5 visited vertices, got %d", len(collector.Get()))
+	}
+
+	wantNearest := map[int]int{1: 1, 2: 1, 4: 5, 5: 5}
+	for v, want := range wantNearest {
+		got := g.GetVertex(v).NearestSource
+		if got == nil || got.Value != want {
+			t.Fatalf("vertex %v: want nearest source %v, got %v", v, want, got)
+		}
+	}
+	if d := g.GetVertex(3).DistanceFromSource; d != 2 {
+		t.Fatalf("vertex 3: want distance 2, got %v", d)
+	}
+
+	// The nearest sources are preserved when cloning
+	clone := g.Clone()
+	if clone.GetVertex(2).NearestSource != clone.GetVertex(1) {
+		t.Fatal("cloned nearest source does not refer to the cloned vertex")
+	}
+
+	// Short-circuit walking by signalling ErrStopWalking
+	visited := 0
+	walkFunc := func(v *graph.Vertex[int]) error {
+		visited++
+		if visited == 2 {
+			return graph.ErrStopWalking
+		}
+		return nil
+	}
+	if err := graph.WalkBFSMulti(g, []int{1, 5}, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if visited != 2 {
+		t.Fatalf("want 2 visited vertices, got %d", visited)
+	}
+
+	// Unknown source vertex
+	if err := graph.WalkBFSMulti(g, []int{1, 42}, collector.WalkFunc); err == nil {
+		t.Fatal("expected error for unknown source vertex")
+	}
+}
+
+func TestDijkstraMulti(t *testing.T) {
+	g := graph.New[string](graph.KindDirected)
+	g.AddWeightedEdge("a", "x", 1)
+	g.AddWeightedEdge("b", "x", 3)
+	g.AddWeightedEdge("b", "y", 1)
+	g.AddWeightedEdge("x", "y", 1)
+	g.AddWeightedEdge("y", "a", 5)
+	g.AddVertex("z")
+
+	dist, nearest, err := graph.DijkstraMulti(g, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[string]float64{"a": 0, "b": 0, "x": 1, "y": 1}, dist, 1e-9)
+	if nearest["x"] != "a" || nearest["y"] != "b" {
+		t.Fatalf("unexpected nearest sources %v", nearest)
+	}
+	if _, ok := dist["z"]; ok {
+		t.Fatal("unreachable vertex must not have a distance")
+	}
+	if g.GetVertex("y").Parent.Value != "b" {
+		t.Fatal("parent of y must be b")
+	}
+
+	// No sources at all
+	dist, _, err = graph.DijkstraMulti(g, nil)
+	if err != nil || len(dist) != 0 {
+		t.Fatalf("want no distances, got %v (%v)", dist, err)
+	}
+
+	// Unknown source vertex
+	if _, _, err := graph.DijkstraMulti(g, []string{"unknown"}); err == nil {
+		t.Fatal("expected error for unknown source vertex")
+	}
+
+	// Negative weights are not supported
+	g.AddWeightedEdge("x", "z", -1)
+	if _, _, err := graph.DijkstraMulti(g, []string{"a"}); err == nil {
+		t.Fatal("expected error for negative weight")
+	}
+}
+
+func TestMultiSourceRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(49))
+	for i := 0; i < 100; i++ {
+		n := r.Intn(30) + 1
+		var g graph.Graph[int]
+		if i%2 == 0 {
+			g = newRandomDirectedGraph(r, n, 0.1, false)
+		} else {
+			g = newRandomUndirectedGraph(r, n, 0.1)
+		}
+		sources := make([]int, r.Intn(4)+1)
+		for j := range sources {
+			sources[j] = r.Intn(n)
+		}
+
+		wantHops := nearestDistances(g, sources, graph.WalkBFS[int])
+		wantDist := nearestDistances(g, sources, graph.WalkDijkstra[int])
+
+		visited := 0
+		walkFunc := func(v *graph.Vertex[int]) error {
+			visited++
+			if want := wantHops[v.Value]; v.DistanceFromSource != want {
+				t.Fatalf("graph %d: vertex %v: want %v edges, got %v", i, v.Value, want, v.DistanceFromSource)
+			}
+			return nil
+		}
+		if err := graph.WalkBFSMulti(g, sources, walkFunc); err != nil {
+			t.Fatal(err)
+		}
+		if visited != len(wantHops) {
+			t.Fatalf("graph %d: want %d visited vertices, got %d", i, len(wantHops), visited)
+		}
+
+		dist, nearest, err := graph.DijkstraMulti(g, sources)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyFloatMap(t, wantDist, dist, 1e-9)
+		for v, source := range nearest {
+			d := walkDistances(g, source, graph.WalkDijkstra[int])
+			if math.Abs(d[v]-dist[v]) > 1e-9 {
+				t.Fatalf("graph %d: vertex %v is not nearest to source %v", i, v, source)
+			}
+		}
+	}
+}
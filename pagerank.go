@@ -0,0 +1,270 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+)
+
+// ErrNotConverged is returned whenever an iterative algorithm fails
+// to converge within the maximum number of iterations.
+var ErrNotConverged = errors.New("iteration did not converge")
+
+// parallelThreshold is the minimum number of vertices in a graph,
+// for which iterative algorithms split their work across multiple
+// goroutines.
+const parallelThreshold = 1024
+
+// PageRankOptions represents the options used when computing the
+// PageRank of the vertices in a graph.
+type PageRankOptions[T comparable] struct {
+	// DampingFactor is the probability of following an edge,
+	// instead of teleporting to a random vertex.
+	DampingFactor float64
+
+	// Tolerance is the error tolerance used to check for
+	// convergence of the power iterations.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of power iterations.
+	MaxIterations int
+
+	// Weighted specifies whether the transition probabilities are
+	// proportional to the edge weights. When false, all outgoing
+	// edges of a vertex are followed with equal probability.
+	Weighted bool
+
+	// Personalization is the restart vector used when teleporting.
+	// Vertices which are not present in the map get a value of
+	// zero. When nil, teleporting picks any vertex with equal
+	// probability. The values are normalized to sum up to one.
+	Personalization map[T]float64
+
+	// Dangling specifies how the rank of dangling vertices, which
+	// have no outgoing edges, is redistributed. When nil, the
+	// personalization vector is used.
+	Dangling map[T]float64
+
+	// Workers is the number of goroutines used to perform the
+	// power iterations on large graphs. When zero, the value of
+	// runtime.GOMAXPROCS is used.
+	Workers int
+}
+
+// DefaultPageRankOptions returns the default options for computing
+// PageRank.
+func DefaultPageRankOptions[T comparable]() *PageRankOptions[T] {
+	opts := &PageRankOptions[T]{
+		DampingFactor: 0.85,
+		Tolerance:     1.0e-6,
+		MaxIterations: 100,
+	}
+
+	return opts
+}
+
+// normalizeVector converts the given map into a vector of values
+// indexed by the vertex positions, which sums up to one. A nil map
+// results in a uniform vector.
+func normalizeVector[T comparable](idx *vertexIndex[T], items map[T]float64) ([]float64, error) {
+	n := len(idx.values)
+	result := make([]float64, n)
+	if items == nil {
+		for i := range result {
+			result[i] = 1.0 / float64(n)
+		}
+		return result, nil
+	}
+
+	total := 0.0
+	for k, v := range items {
+		i, ok := idx.index[k]
+		if !ok {
+			return nil, fmt.Errorf("Vertex %v not found in the graph", k)
+		}
+		if v < 0 {
+			return nil, fmt.Errorf("Negative value %v for vertex %v", v, k)
+		}
+		result[i] = v
+		total += v
+	}
+
+	if total == 0 {
+		return nil, errors.New("Vector values must not sum up to zero")
+	}
+
+	for i := range result {
+		result[i] /= total
+	}
+
+	return result, nil
+}
+
+// parallelFor calls fn for each chunk of the range [0, n), splitting
+// the work across the given number of goroutines.
+func parallelFor(n, workers int, fn func(lo, hi int)) {
+	if workers <= 1 || n < parallelThreshold {
+		fn(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	chunk := (n + workers - 1) / workers
+	for lo := 0; lo < n; lo += chunk {
+		hi := min(lo+chunk, n)
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			fn(lo, hi)
+		}(lo, hi)
+	}
+	wg.Wait()
+}
+
+// PageRank computes the PageRank of the vertices in the graph using
+// power iterations. Edges of undirected graphs are followed in both
+// directions.
+//
+// When opts is nil, the options returned by DefaultPageRankOptions
+// are used. The resulting ranks sum up to one.
+func PageRank[T comparable](g Graph[T], opts *PageRankOptions[T]) (map[T]float64, error) {
+	if opts == nil {
+		opts = DefaultPageRankOptions[T]()
+	}
+
+	if opts.DampingFactor < 0 || opts.DampingFactor > 1 {
+		return nil, fmt.Errorf("Invalid damping factor %v", opts.DampingFactor)
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	result := make(map[T]float64, n)
+	if n == 0 {
+		return result, nil
+	}
+
+	personalization, err := normalizeVector(idx, opts.Personalization)
+	if err != nil {
+		return nil, err
+	}
+
+	dangling := personalization
+	if opts.Dangling != nil {
+		dangling, err = normalizeVector(idx, opts.Dangling)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Compute the total outgoing weight of each vertex
+	arcs := idx.arcs(g)
+	outWeight := make([]float64, n)
+	for v, items := range arcs {
+		for _, arc := range items {
+			w := 1.0
+			if opts.Weighted {
+				if arc.weight < 0 {
+					return nil, fmt.Errorf("Negative weight for edge %v-%v", arc.edge.From, arc.edge.To)
+				}
+				w = arc.weight
+			}
+			outWeight[v] += w
+		}
+	}
+
+	// Build the incoming transitions of each vertex, so that each
+	// vertex can pull its new rank independently of the others.
+	type transition struct {
+		from int
+		prob float64
+	}
+	incoming := make([][]transition, n)
+	isDangling := make([]bool, n)
+	for v, items := range arcs {
+		if outWeight[v] == 0 {
+			isDangling[v] = true
+			continue
+		}
+		for _, arc := range items {
+			w := 1.0
+			if opts.Weighted {
+				w = arc.weight
+			}
+			incoming[arc.to] = append(incoming[arc.to], transition{from: v, prob: w / outWeight[v]})
+		}
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	d := opts.DampingFactor
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1.0 / float64(n)
+	}
+	next := make([]float64, n)
+
+	for iter := 0; iter < opts.MaxIterations; iter++ {
+		danglingSum := 0.0
+		for v := 0; v < n; v++ {
+			if isDangling[v] {
+				danglingSum += x[v]
+			}
+		}
+
+		parallelFor(n, workers, func(lo, hi int) {
+			for v := lo; v < hi; v++ {
+				rank := 0.0
+				for _, t := range incoming[v] {
+					rank += x[t.from] * t.prob
+				}
+				next[v] = d*(rank+danglingSum*dangling[v]) + (1-d)*personalization[v]
+			}
+		})
+
+		// Check for convergence
+		delta := 0.0
+		for v := 0; v < n; v++ {
+			delta += math.Abs(next[v] - x[v])
+		}
+		x, next = next, x
+
+		if delta < float64(n)*opts.Tolerance {
+			for i, v := range idx.values {
+				result[v] = x[i]
+			}
+			return result, nil
+		}
+	}
+
+	return nil, ErrNotConverged
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which compares a map of floats against the
+// expected values within the given tolerance
+func verifyFloatMap[T comparable](t *testing.T, want map[T]float64, got map[T]float64, tolerance float64) {
+	if len(want) != len(got) {
+		t.Fatalf("got %d number of values, want %d", len(got), len(want))
+	}
+
+	for k, v := range want {
+		if math.Abs(got[k]-v) > tolerance {
+			t.Fatalf("want value %.6f for %v, got %.6f", v, k, got[k])
+		}
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	// (1) and (2) point to each other, while (3) points to (1)
+	g := graph.New[int](graph.KindDirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+	g.AddEdge(3, 1)
+
+	opts := graph.DefaultPageRankOptions[int]()
+	opts.Tolerance = 1.0e-10
+	opts.MaxIterations = 500
+	got, err := graph.PageRank(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]float64{
+		1: 0.135 / 0.2775,
+		2: 0.05 + 0.85*(0.135/0.2775),
+		3: 0.05,
+	}
+	verifyFloatMap(t, want, got, 1.0e-6)
+
+	// Vertices of a cycle have equal rank
+	cycle := graph.New[int](graph.KindDirected)
+	for i := 0; i < 5; i++ {
+		cycle.AddEdge(i, (i+1)%5)
+	}
+	got, err = graph.PageRank(cycle, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyFloatMap(t, map[int]float64{0: 0.2, 1: 0.2, 2: 0.2, 3: 0.2, 4: 0.2}, got, 1.0e-6)
+
+	// Dangling vertices redistribute their rank
+	star := graph.New[int](graph.KindDirected)
+	star.AddEdge(1, 0)
+	star.AddEdge(2, 0)
+	star.AddEdge(3, 0)
+	got, err = graph.PageRank(star, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0.0
+	for _, v := range got {
+		total += v
+	}
+	if math.Abs(total-1.0) > 1.0e-6 {
+		t.Fatalf("ranks must sum up to 1, got %.6f", total)
+	}
+	if got[0] <= got[1] {
+		t.Fatal("hub vertex must have the highest rank")
+	}
+
+	// Edge weights affect the transition probabilities
+	weighted := graph.New[int](graph.KindDirected)
+	weighted.AddWeightedEdge(1, 2, 9)
+	weighted.AddWeightedEdge(1, 3, 1)
+	weighted.AddEdge(2, 1)
+	weighted.AddEdge(3, 1)
+	opts = graph.DefaultPageRankOptions[int]()
+	opts.Weighted = true
+	got, err = graph.PageRank(weighted, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[2] <= got[3] {
+		t.Fatal("heavier edge must result in higher rank")
+	}
+
+	// Personalised PageRank never reaches vertices outside of the
+	// restart vector, unless there is a path to them
+	personalised := graph.New[int](graph.KindDirected)
+	personalised.AddEdge(1, 2)
+	personalised.AddEdge(2, 1)
+	personalised.AddEdge(3, 4)
+	personalised.AddEdge(4, 3)
+	opts = graph.DefaultPageRankOptions[int]()
+	opts.Personalization = map[int]float64{1: 1.0}
+	got, err = graph.PageRank(personalised, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[3] > 1.0e-4 || got[4] > 1.0e-4 {
+		t.Fatal("unreachable vertices must have negligible personalised rank")
+	}
+	if got[1] <= got[2] {
+		t.Fatal("restart vertex must have the highest personalised rank")
+	}
+
+	// Unknown vertex in the personalization vector
+	opts.Personalization = map[int]float64{42: 1.0}
+	if _, err := graph.PageRank(personalised, opts); err == nil {
+		t.Fatal("PageRank: should fail with unknown vertex")
+	}
+
+	// Failure to converge
+	opts = graph.DefaultPageRankOptions[int]()
+	opts.MaxIterations = 1
+	opts.Tolerance = 0
+	if _, err := graph.PageRank(g, opts); err != graph.ErrNotConverged {
+		t.Fatal("PageRank: should fail to converge")
+	}
+}
+
+func TestPageRankParallel(t *testing.T) {
+	// Large random graph, which is processed by multiple
+	// goroutines
+	r := rand.New(rand.NewSource(42))
+	g := graph.New[int](graph.KindDirected)
+	for i := 0; i < 3000; i++ {
+		g.AddVertex(i)
+		for j := 0; j < 3; j++ {
+			g.AddEdge(i, r.Intn(3000))
+		}
+	}
+
+	opts := graph.DefaultPageRankOptions[int]()
+	opts.Workers = 1
+	sequential, err := graph.PageRank(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Workers = 4
+	parallel, err := graph.PageRank(g, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifyFloatMap(t, sequential, parallel, 1.0e-12)
+}
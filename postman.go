@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNoPostmanTour is returned whenever the graph does not contain a
+// closed walk, which traverses each edge at least once.
+var ErrNoPostmanTour = errors.New("no postman tour exists")
+
+// ChinesePostman solves the route inspection problem, by finding a
+// closed walk of minimum total weight, which traverses each edge of
+// the graph at least once. The result contains the vertices along the
+// walk, where the first and the last one are the same, along with
+// the total weight of the walk.
+//
+// For undirected graphs the vertices with odd degree are paired by a
+// minimum weight perfect matching over their shortest path
+// distances, and the edges along the paths between the pairs are
+// duplicated. For directed graphs the edges to duplicate are found
+// by a minimum cost flow from the vertices with excess in-degree to
+// the vertices with excess out-degree. An Eulerian circuit of the
+// resulting multigraph forms the walk.
+//
+// Edge weights must be non-negative, and all edges must belong to the
+// same connected component. A directed graph must also allow
+// returning from the end of each edge to its start. Otherwise, an
+// error wrapping ErrNoPostmanTour is returned.
+func ChinesePostman[T comparable](g Graph[T]) ([]T, float64, error) {
+	if err := validateWeights(g); err != nil {
+		return nil, 0, err
+	}
+
+	idx := newVertexIndex(g)
+	if err := checkEdgesConnected(g, idx, ErrNoPostmanTour); err != nil {
+		return nil, 0, err
+	}
+
+	edges := g.GetEdges()
+	if len(edges) == 0 {
+		return []T{}, 0, nil
+	}
+
+	weight := 0.0
+	for _, e := range edges {
+		weight += e.Weight
+	}
+
+	var extra [][2]int
+	var extraWeight float64
+	var err error
+	switch g.Kind() {
+	case KindDirected:
+		extra, extraWeight, err = directedPostmanEdges(g, idx)
+	default:
+		extra, extraWeight, err = undirectedPostmanEdges(g, idx)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	multigraph := append(idx.edges(g), extra...)
+	start := idx.index[edges[0].From]
+	walk := hierholzer(len(idx.values), multigraph, g.Kind() == KindDirected, start)
+
+	return trailValues(idx, walk), weight + extraWeight, nil
+}
+
+// undirectedPostmanEdges returns the edges to duplicate in an
+// undirected graph, so that each vertex has an even degree, along
+// with their total weight.
+func undirectedPostmanEdges[T comparable](g Graph[T], idx *vertexIndex[T]) ([][2]int, float64, error) {
+	odd := make([]int, 0)
+	for i, v := range idx.values {
+		if g.GetVertex(v).Degree.Out%2 != 0 {
+			odd = append(odd, i)
+		}
+	}
+	if len(odd) == 0 {
+		return nil, 0, nil
+	}
+
+	// Shortest paths from each vertex with odd degree. Since all
+	// edges are connected, each pair of them is reachable.
+	arcs := idx.arcs(g)
+	paths := make(map[int]*shortestPaths, len(odd))
+	maxDist := 0.0
+	for _, v := range odd {
+		paths[v] = singleSourceShortestPaths(arcs, v, true)
+		for _, u := range odd {
+			maxDist = max(maxDist, paths[v].dist[u])
+		}
+	}
+
+	// Find a minimum weight perfect matching of the vertices with
+	// odd degree, by maximizing the complementary distances among
+	// the matchings of maximum cardinality
+	h := New[int](KindUndirected)
+	for i, v := range odd {
+		for _, u := range odd[i+1:] {
+			h.AddWeightedEdge(v, u, maxDist+1-paths[v].dist[u])
+		}
+	}
+	matching, err := MaxWeightMatching(h, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	extra := make([][2]int, 0)
+	total := 0.0
+	for _, e := range matching {
+		sp := paths[e.From]
+		total += sp.dist[e.To]
+		for v := e.To; v != e.From; v = sp.preds[v][0] {
+			extra = append(extra, [2]int{sp.preds[v][0], v})
+		}
+	}
+
+	return extra, total, nil
+}
+
+// directedPostmanEdges returns the edges to duplicate in a directed
+// graph, so that each vertex has equal in- and out-degree, along with
+// their total weight.
+func directedPostmanEdges[T comparable](g Graph[T], idx *vertexIndex[T]) ([][2]int, float64, error) {
+	n := len(idx.values)
+	source, sink := n, n+1
+	network := newFlowNetwork(n + 2)
+
+	edges := g.GetEdges()
+	ids := make([]int, len(edges))
+	for i, e := range edges {
+		ids[i] = network.addArc(idx.index[e.From], idx.index[e.To], math.Inf(1), e.Weight)
+	}
+
+	// Vertices with excess in-degree need additional outgoing
+	// edges, which lead to vertices with excess out-degree
+	demand := 0.0
+	for i, v := range idx.values {
+		vertex := g.GetVertex(v)
+		balance := vertex.Degree.In - vertex.Degree.Out
+		if balance > 0 {
+			network.addArc(source, i, float64(balance), 0)
+			demand += float64(balance)
+		} else if balance < 0 {
+			network.addArc(i, sink, float64(-balance), 0)
+		}
+	}
+
+	flow, cost := network.minCostFlow(source, sink, demand)
+	if flow < demand {
+		return nil, 0, fmt.Errorf("%w: edges cannot be balanced", ErrNoPostmanTour)
+	}
+
+	extra := make([][2]int, 0)
+	for i, e := range edges {
+		for k := 0; k < int(network.flow(ids[i])); k++ {
+			extra = append(extra, [2]int{idx.index[e.From], idx.index[e.To]})
+		}
+	}
+
+	return extra, cost, nil
+}
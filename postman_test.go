@@ -0,0 +1,211 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which verifies that the given walk is closed,
+// traverses each edge of the graph at least once, and has the given
+// total weight
+func verifyPostmanTour[T comparable](t *testing.T, g graph.Graph[T], walk []T, weight float64) {
+	if walk[0] != walk[len(walk)-1] {
+		t.Fatalf("want closed walk, got %v", walk)
+	}
+
+	used := make(map[*graph.Edge[T]]bool)
+	total := 0.0
+	for i := 0; i+1 < len(walk); i++ {
+		e := g.GetEdge(walk[i], walk[i+1])
+		if e == nil {
+			t.Fatalf("no edge between %v and %v", walk[i], walk[i+1])
+		}
+		used[e] = true
+		total += e.Weight
+	}
+
+	if len(used) != len(g.GetEdges()) {
+		t.Fatalf("want %d edges traversed, got %d", len(g.GetEdges()), len(used))
+	}
+	if math.Abs(total-weight) > 1e-9 {
+		t.Fatalf("want walk weight %v, got %v", total, weight)
+	}
+}
+
+// A helper function which returns the minimum weight of a closed walk
+// traversing each edge, by trying each number of duplicates up to the
+// given limit for each edge
+func bruteForcePostman(g graph.Graph[int], limit int) float64 {
+	edges := g.GetEdges()
+	copies := make([]int, len(edges))
+	best := math.Inf(1)
+
+	var try func(k int)
+	try = func(k int) {
+		if k < len(edges) {
+			for c := 0; c <= limit; c++ {
+				copies[k] = c
+				try(k + 1)
+			}
+			return
+		}
+
+		balance := make(map[int]int)
+		weight := 0.0
+		for i, e := range edges {
+			weight += float64(copies[i]+1) * e.Weight
+			balance[e.From] += copies[i] + 1
+			balance[e.To] -= copies[i] + 1
+			if g.Kind() == graph.KindUndirected {
+				balance[e.To] += 2 * (copies[i] + 1)
+			}
+		}
+		for _, b := range balance {
+			if (g.Kind() == graph.KindUndirected && b%2 != 0) || (g.Kind() == graph.KindDirected && b != 0) {
+				return
+			}
+		}
+		best = min(best, weight)
+	}
+	try(0)
+
+	return best
+}
+
+func TestChinesePostmanUndirected(t *testing.T) {
+	// Path a-b-c must be walked twice
+	g := graph.New[string](graph.KindUndirected)
+	g.AddWeightedEdge("a", "b", 2)
+	g.AddWeightedEdge("b", "c", 3)
+
+	walk, weight, err := graph.ChinesePostman(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyPostmanTour(t, g, walk, weight)
+	if weight != 10 {
+		t.Fatalf("want walk weight 10, got %v", weight)
+	}
+
+	// Square with a diagonal, where the odd vertices b and d are
+	// better connected through the path b-c-d
+	sq := graph.New[string](graph.KindUndirected)
+	sq.AddWeightedEdge("a", "b", 1)
+	sq.AddWeightedEdge("b", "c", 1)
+	sq.AddWeightedEdge("c", "d", 1)
+	sq.AddWeightedEdge("d", "a", 1)
+	sq.AddWeightedEdge("b", "d", 5)
+
+	walk, weight, err = graph.ChinesePostman(sq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyPostmanTour(t, sq, walk, weight)
+	if weight != 11 {
+		t.Fatalf("want walk weight 11, got %v", weight)
+	}
+
+	// Edges in separate components
+	g.AddWeightedEdge("x", "y", 1)
+	if _, _, err := graph.ChinesePostman(g); !errors.Is(err, graph.ErrNoPostmanTour) {
+		t.Fatalf("want ErrNoPostmanTour, got %v", err)
+	}
+}
+
+func TestChinesePostmanDirected(t *testing.T) {
+	g := graph.New[int](graph.KindDirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 1)
+	g.AddWeightedEdge(3, 1, 1)
+	g.AddWeightedEdge(1, 3, 4)
+
+	// Edge 1->3 requires returning to 1 through 3->1
+	walk, weight, err := graph.ChinesePostman(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyPostmanTour(t, g, walk, weight)
+	if weight != 8 {
+		t.Fatalf("want walk weight 8, got %v", weight)
+	}
+
+	// No way back from 4
+	g.AddWeightedEdge(3, 4, 1)
+	if _, _, err := graph.ChinesePostman(g); !errors.Is(err, graph.ErrNoPostmanTour) {
+		t.Fatalf("want ErrNoPostmanTour, got %v", err)
+	}
+}
+
+func TestChinesePostmanRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(37))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomUndirectedGraph(r, 6, 0.5)
+		if len(g.GetEdges()) > 12 {
+			continue
+		}
+		walk, weight, err := graph.ChinesePostman(g)
+		if errors.Is(err, graph.ErrNoPostmanTour) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyPostmanTour(t, g, walk, weight)
+
+		// Optimal walks never traverse an undirected edge
+		// more than twice
+		if want := bruteForcePostman(g, 1); math.Abs(weight-want) > 1e-9 {
+			t.Fatalf("want walk weight %v, got %v", want, weight)
+		}
+	}
+
+	for iter := 0; iter < 20; iter++ {
+		// A directed cycle with random chords is strongly
+		// connected
+		g := graph.New[int](graph.KindDirected)
+		for i := 0; i < 4; i++ {
+			g.AddWeightedEdge(i, (i+1)%4, float64(r.Intn(10)+1))
+		}
+		for i := 0; i < 3; i++ {
+			g.AddWeightedEdge(r.Intn(4), r.Intn(4), float64(r.Intn(10)+1))
+		}
+
+		walk, weight, err := graph.ChinesePostman(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyPostmanTour(t, g, walk, weight)
+		if want := bruteForcePostman(g, 3); weight > want+1e-9 {
+			t.Fatalf("want walk weight at most %v, got %v", want, weight)
+		}
+	}
+}
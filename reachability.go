@@ -0,0 +1,370 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// bitset represents a set of non-negative integers
+type bitset []uint64
+
+// newBitset creates a new bitset, which can hold integers in the
+// range [0, n)
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+// set adds the integer to the set
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+// has returns a boolean indicating whether the integer is in the set
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// clear removes the integer from the set
+func (b bitset) clear(i int) {
+	b[i/64] &^= 1 << (i % 64)
+}
+
+// union adds the integers from the other set to the set
+func (b bitset) union(other bitset) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+// intersect removes the integers, which are not in the other set
+func (b bitset) intersect(other bitset) {
+	for i := range b {
+		b[i] &= other[i]
+	}
+}
+
+// difference removes the integers, which are in the other set
+func (b bitset) difference(other bitset) {
+	for i := range b {
+		b[i] &^= other[i]
+	}
+}
+
+// xor replaces the set with the symmetric difference of both sets
+func (b bitset) xor(other bitset) {
+	for i := range b {
+		b[i] ^= other[i]
+	}
+}
+
+// lowest returns the smallest integer in the set, or -1 if the set
+// is empty
+func (b bitset) lowest() int {
+	for k, word := range b {
+		if word != 0 {
+			return k*64 + bits.TrailingZeros64(word)
+		}
+	}
+
+	return -1
+}
+
+// forEach calls fn for each integer in the set in increasing order
+func (b bitset) forEach(fn func(i int)) {
+	for k, word := range b {
+		for word != 0 {
+			i := bits.TrailingZeros64(word)
+			fn(k*64 + i)
+			word &^= 1 << i
+		}
+	}
+}
+
+// stronglyConnectedComponents finds the strongly connected components
+// of the graph given by its adjacency lists, using an iterative
+// version of Tarjan's algorithm. Returns the component of each vertex
+// position and the number of components. Components are numbered in
+// reverse topological order, so that each arc leads to a component
+// with the same or a lower number.
+func stronglyConnectedComponents(adj [][]int) ([]int, int) {
+	n := len(adj)
+	index := make([]int, n)
+	low := make([]int, n)
+	comp := make([]int, n)
+	onStack := make([]bool, n)
+	next := make([]int, n)
+	for v := range index {
+		index[v] = -1
+	}
+
+	stack := make([]int, 0)
+	calls := make([]int, 0)
+	counter := 0
+	count := 0
+
+	for root := 0; root < n; root++ {
+		if index[root] != -1 {
+			continue
+		}
+
+		calls = append(calls, root)
+		index[root] = counter
+		low[root] = counter
+		counter++
+		stack = append(stack, root)
+		onStack[root] = true
+
+		for len(calls) > 0 {
+			v := calls[len(calls)-1]
+			if next[v] < len(adj[v]) {
+				u := adj[v][next[v]]
+				next[v]++
+				if index[u] == -1 {
+					// Descend into the neighbour
+					index[u] = counter
+					low[u] = counter
+					counter++
+					stack = append(stack, u)
+					onStack[u] = true
+					calls = append(calls, u)
+				} else if onStack[u] {
+					low[v] = min(low[v], index[u])
+				}
+				continue
+			}
+
+			// Done with V, return to its caller
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1]
+				low[parent] = min(low[parent], low[v])
+			}
+
+			if low[v] == index[v] {
+				// V is the root of a component
+				for {
+					u := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[u] = false
+					comp[u] = count
+					if u == v {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+
+	return comp, count
+}
+
+// ReachabilityIndex answers reachability queries between the vertices
+// of a graph in constant time. The index is computed once from the
+// strongly connected components of the graph, and the set of
+// components reachable from each component.
+//
+// The index is a snapshot of the graph at the time of its creation,
+// and is not updated when the graph is modified.
+type ReachabilityIndex[T comparable] struct {
+	idx   *vertexIndex[T]
+	comp  []int
+	reach []bitset
+}
+
+// NewReachabilityIndex creates a new reachability index for the
+// graph. Computing the index takes O(c * (n + m) / 64) time and
+// O(c^2 / 64) memory, where c is the number of strongly connected
+// components of the graph.
+func NewReachabilityIndex[T comparable](g Graph[T]) *ReachabilityIndex[T] {
+	idx := newVertexIndex(g)
+	adj := idx.adjacency(g)
+	comp, count := stronglyConnectedComponents(adj)
+
+	// Group the vertices by their component
+	members := make([][]int, count)
+	for v, c := range comp {
+		members[c] = append(members[c], v)
+	}
+
+	// Arcs only lead to components with a lower or the same
+	// number, so the reachable sets of the successors are
+	// complete by the time we get to a component.
+	reach := make([]bitset, count)
+	for c := 0; c < count; c++ {
+		reach[c] = newBitset(count)
+		reach[c].set(c)
+		for _, v := range members[c] {
+			for _, u := range adj[v] {
+				if comp[u] != c {
+					reach[c].union(reach[comp[u]])
+				}
+			}
+		}
+	}
+
+	index := &ReachabilityIndex[T]{
+		idx:   idx,
+		comp:  comp,
+		reach: reach,
+	}
+
+	return index
+}
+
+// Reachable returns a boolean indicating whether there is a path from
+// one vertex to the other. Each vertex is reachable from itself.
+func (ri *ReachabilityIndex[T]) Reachable(from, to T) (bool, error) {
+	u, ok := ri.idx.index[from]
+	if !ok {
+		return false, fmt.Errorf("Source vertex %v not found in the graph", from)
+	}
+	v, ok := ri.idx.index[to]
+	if !ok {
+		return false, fmt.Errorf("Destination vertex %v not found in the graph", to)
+	}
+
+	return ri.reach[ri.comp[u]].has(ri.comp[v]), nil
+}
+
+// Reachable returns a boolean indicating whether there is a path from
+// one vertex to the other in the graph. Each vertex is reachable from
+// itself.
+//
+// Each call performs a Depth-first Search (DFS) traversal of the
+// graph. Use a ReachabilityIndex for answering repeated queries
+// instead.
+func Reachable[T comparable](g Graph[T], from, to T) (bool, error) {
+	if !g.VertexExists(to) {
+		return false, fmt.Errorf("Destination vertex %v not found in the graph", to)
+	}
+
+	found := false
+	walkFunc := func(v *Vertex[T]) error {
+		if v.Value == to {
+			found = true
+			return ErrStopWalking
+		}
+
+		return nil
+	}
+
+	if err := WalkPreOrderDFS(g, from, walkFunc); err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// TransitiveClosure returns a new directed graph, which contains an
+// edge from each vertex to every other vertex reachable from it by a
+// non-empty path. A vertex has an edge to itself only if it lies on a
+// cycle. Existing edges are preserved along with their weights and
+// attributes, and the new edges have zero weight.
+func TransitiveClosure[T comparable](g Graph[T]) (Graph[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	ri := NewReachabilityIndex(g)
+	idx := ri.idx
+	adj := idx.adjacency(g)
+	n := len(idx.values)
+
+	// Vertices of each component
+	members := make([][]int, len(ri.reach))
+	for v, c := range ri.comp {
+		members[c] = append(members[c], v)
+	}
+
+	result := g.Clone()
+	for v := 0; v < n; v++ {
+		// Components reachable by a non-empty path are the
+		// ones reachable from any of the successors
+		strict := newBitset(len(ri.reach))
+		for _, u := range adj[v] {
+			strict.union(ri.reach[ri.comp[u]])
+		}
+
+		strict.forEach(func(c int) {
+			for _, u := range members[c] {
+				result.AddEdge(idx.values[v], idx.values[u])
+			}
+		})
+	}
+
+	return result, nil
+}
+
+// TransitiveReduction returns a new directed graph with the same
+// reachability as the given directed acyclic graph, and the minimum
+// number of edges. An edge is kept, only if there is no other path
+// between its vertices. The vertices and the kept edges preserve
+// their weights and attributes.
+//
+// Returns ErrCycleDetected, if the graph is not acyclic.
+func TransitiveReduction[T comparable](g Graph[T]) (Graph[T], error) {
+	if g.Kind() != KindDirected {
+		return nil, ErrIsNotDirectedGraph
+	}
+
+	ri := NewReachabilityIndex(g)
+	idx := ri.idx
+	if len(ri.reach) != len(idx.values) {
+		return nil, ErrCycleDetected
+	}
+	for _, e := range g.GetEdges() {
+		if e.From == e.To {
+			return nil, ErrCycleDetected
+		}
+	}
+
+	// An edge V->U is implied, if U is reachable from another
+	// successor of V. In a DAG each component is a single vertex,
+	// and U cannot be reachable from itself by a non-empty path.
+	adj := idx.adjacency(g)
+	implied := make([]bitset, len(idx.values))
+	for v := range implied {
+		implied[v] = newBitset(len(ri.reach))
+		for _, u := range adj[v] {
+			for _, w := range adj[u] {
+				implied[v].union(ri.reach[ri.comp[w]])
+			}
+		}
+	}
+
+	keepVertex := func(v T) bool {
+		return true
+	}
+	keepEdge := func(e *Edge[T]) bool {
+		from, to := idx.index[e.From], idx.index[e.To]
+		return !implied[from].has(ri.comp[to])
+	}
+
+	return filteredSubgraph(g, keepVertex, keepEdge), nil
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which creates a random directed graph. If acyclic
+// is true, edges only lead from lower to higher vertices.
+func newRandomDirectedGraph(r *rand.Rand, n int, p float64, acyclic bool) graph.Graph[int] {
+	g := graph.New[int](graph.KindDirected)
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (acyclic && j <= i) || i == j {
+				continue
+			}
+			if r.Float64() < p {
+				g.AddWeightedEdge(i, j, float64(r.Intn(20)+1))
+			}
+		}
+	}
+
+	return g
+}
+
+// A helper function which returns the pairs of distinct vertices
+// connected by a path, computed with the Floyd-Warshall algorithm
+func bruteForceReachable(g graph.Graph[int]) map[[2]int]bool {
+	n := len(g.GetVertices())
+	reach := make(map[[2]int]bool)
+	for _, e := range g.GetEdges() {
+		reach[[2]int{e.From, e.To}] = true
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if reach[[2]int{i, k}] && reach[[2]int{k, j}] {
+					reach[[2]int{i, j}] = true
+				}
+			}
+		}
+	}
+
+	return reach
+}
+
+func TestReachable(t *testing.T) {
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "d")
+	g.AddVertex("e")
+
+	ri := graph.NewReachabilityIndex(g)
+	tests := []struct {
+		from string
+		to   string
+		want bool
+	}{
+		{"a", "a", true},
+		{"a", "d", true},
+		{"c", "b", true},
+		{"d", "a", false},
+		{"e", "a", false},
+		{"a", "e", false},
+	}
+
+	for _, test := range tests {
+		got, err := graph.Reachable(g, test.from, test.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Fatalf("want Reachable(%v, %v) %v, got %v", test.from, test.to, test.want, got)
+		}
+
+		got, err = ri.Reachable(test.from, test.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Fatalf("want indexed Reachable(%v, %v) %v, got %v", test.from, test.to, test.want, got)
+		}
+	}
+
+	if _, err := ri.Reachable("a", "x"); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+	if _, err := graph.Reachable(g, "x", "a"); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+}
+
+func TestReachableRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(38))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomDirectedGraph(r, 70, 0.02, false)
+		want := bruteForceReachable(g)
+		ri := graph.NewReachabilityIndex(g)
+		for i := 0; i < 70; i++ {
+			for j := 0; j < 70; j++ {
+				got, err := ri.Reachable(i, j)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != (i == j || want[[2]int{i, j}]) {
+					t.Fatalf("want Reachable(%v, %v) %v, got %v", i, j, !got, got)
+				}
+			}
+		}
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	r := rand.New(rand.NewSource(38))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomDirectedGraph(r, 12, 0.1, iter%2 == 0)
+		g.AddWeightedEdge(0, 1, 42)
+		closure, err := graph.TransitiveClosure(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := bruteForceReachable(g)
+		if len(closure.GetEdges()) != len(want) {
+			t.Fatalf("want %d edges, got %d", len(want), len(closure.GetEdges()))
+		}
+		for pair := range want {
+			if !closure.EdgeExists(pair[0], pair[1]) {
+				t.Fatalf("want edge %v-%v in the closure", pair[0], pair[1])
+			}
+		}
+		if w := closure.GetEdge(0, 1).Weight; w != 42 {
+			t.Fatalf("want weight 42 for edge 0-1, got %v", w)
+		}
+	}
+
+	if _, err := graph.TransitiveClosure(graph.New[int](graph.KindUndirected)); !errors.Is(err, graph.ErrIsNotDirectedGraph) {
+		t.Fatalf("want ErrIsNotDirectedGraph, got %v", err)
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("a", "c")
+	g.AddEdge("c", "d")
+	g.AddEdge("a", "d")
+	g.AddWeightedEdge("b", "e", 7)
+
+	reduction, err := graph.TransitiveReduction(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reduction.GetEdges()) != 4 {
+		t.Fatalf("want 4 edges, got %d", len(reduction.GetEdges()))
+	}
+	if reduction.EdgeExists("a", "c") || reduction.EdgeExists("a", "d") {
+		t.Fatal("want implied edges removed")
+	}
+	if w := reduction.GetEdge("b", "e").Weight; w != 7 {
+		t.Fatalf("want weight 7 for edge b-e, got %v", w)
+	}
+
+	g.AddEdge("d", "a")
+	if _, err := graph.TransitiveReduction(g); !errors.Is(err, graph.ErrCycleDetected) {
+		t.Fatalf("want ErrCycleDetected, got %v", err)
+	}
+}
+
+func TestTransitiveReductionRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(38))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomDirectedGraph(r, 12, 0.4, true)
+		reduction, err := graph.TransitiveReduction(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The reduction has the same reachability, and none of
+		// its edges can be removed without changing it
+		want := bruteForceReachable(g)
+		got := bruteForceReachable(reduction)
+		if len(got) != len(want) {
+			t.Fatalf("want %d reachable pairs, got %d", len(want), len(got))
+		}
+		for _, e := range reduction.GetEdges() {
+			h := reduction.Clone()
+			h.DeleteEdge(e.From, e.To)
+			if bruteForceReachable(h)[[2]int{e.From, e.To}] {
+				t.Fatalf("edge %v-%v is implied", e.From, e.To)
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import "fmt"
+
+// SimplePathOptions represents the options used when walking the
+// simple paths between two vertices.
+type SimplePathOptions[T comparable] struct {
+	// MaxDepth is the maximum number of edges in the walked
+	// paths. When negative, paths with any number of edges are
+	// walked.
+	MaxDepth int
+
+	// MaxLength is the maximum total weight of the edges in the
+	// walked paths. When negative, paths of any length are walked.
+	MaxLength float64
+
+	// EdgeFilter specifies a predicate, which edges must satisfy
+	// in order to be part of the walked paths. The edges are
+	// passed as stored in the graph, so the edges of undirected
+	// graphs may be traversed from their To to their From
+	// vertex. When nil, all edges are considered.
+	EdgeFilter func(e *Edge[T]) bool
+}
+
+// DefaultSimplePathOptions returns the default options for walking
+// the simple paths between two vertices.
+func DefaultSimplePathOptions[T comparable]() *SimplePathOptions[T] {
+	opts := &SimplePathOptions[T]{
+		MaxDepth:   -1,
+		MaxLength:  -1,
+		EdgeFilter: nil,
+	}
+
+	return opts
+}
+
+// WalkAllSimplePaths walks over all simple paths from the source to
+// the destination vertex. A simple path visits each of its vertices
+// once. The walk function receives the vertices along each path,
+// starting with the source and ending with the destination. When the
+// source and destination are the same, only the path consisting of
+// this single vertex is walked.
+//
+// The paths are enumerated by an iterative Depth-first Search (DFS),
+// and the walk function may return ErrStopWalking to stop walking
+// early, since the number of paths may grow exponentially with the
+// size of the graph.
+func WalkAllSimplePaths[T comparable](g Graph[T], source, dest T, opts *SimplePathOptions[T], walkFunc func(path []T) error) error {
+	if !g.VertexExists(source) {
+		return fmt.Errorf("Source vertex %v not found in the graph", source)
+	}
+	if !g.VertexExists(dest) {
+		return fmt.Errorf("Destination vertex %v not found in the graph", dest)
+	}
+	if opts == nil {
+		opts = DefaultSimplePathOptions[T]()
+	}
+
+	if source == dest {
+		err := walkFunc([]T{source})
+		if err == ErrStopWalking {
+			return nil
+		}
+		return err
+	}
+
+	idx := newVertexIndex(g)
+	arcs := idx.arcs(g)
+	n := len(idx.values)
+	target := idx.index[dest]
+
+	// The path is extended by one arc at a time, and the next arc
+	// to try is kept for each vertex along the path
+	onPath := make([]bool, n)
+	path := []int{idx.index[source]}
+	next := []int{0}
+	lengths := []float64{0}
+	onPath[path[0]] = true
+
+	for len(path) > 0 {
+		top := len(path) - 1
+		v := path[top]
+		if next[top] == len(arcs[v]) || (opts.MaxDepth >= 0 && top == opts.MaxDepth) {
+			// Done with V, backtrack
+			onPath[v] = false
+			path = path[:top]
+			next = next[:top]
+			lengths = lengths[:top]
+			continue
+		}
+
+		arc := arcs[v][next[top]]
+		next[top]++
+		length := lengths[top] + arc.weight
+		if onPath[arc.to] || (opts.MaxLength >= 0 && length > opts.MaxLength) {
+			continue
+		}
+		if opts.EdgeFilter != nil && !opts.EdgeFilter(arc.edge) {
+			continue
+		}
+
+		if arc.to == target {
+			err := walkFunc(trailValues(idx, append(path, arc.to)))
+			if err == ErrStopWalking {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		onPath[arc.to] = true
+		path = append(path, arc.to)
+		next = append(next, 0)
+		lengths = append(lengths, length)
+	}
+
+	return nil
+}
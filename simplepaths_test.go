@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which finds the simple paths between two vertices
+// using recursive backtracking
+func bruteForceSimplePaths(g graph.Graph[int], source, dest, maxDepth int, maxLength float64) []string {
+	result := make([]string, 0)
+	var search func(path []int, length float64)
+	search = func(path []int, length float64) {
+		v := path[len(path)-1]
+		if v == dest {
+			result = append(result, fmt.Sprint(path))
+			return
+		}
+		if len(path) > maxDepth {
+			return
+		}
+		for _, u := range g.GetNeighbours(v) {
+			w := length + g.GetEdge(v, u).Weight
+			if !slices.Contains(path, u) && w <= maxLength {
+				search(append(slices.Clone(path), u), w)
+			}
+		}
+	}
+	search([]int{source}, 0)
+	slices.Sort(result)
+
+	return result
+}
+
+func TestWalkAllSimplePaths(t *testing.T) {
+	// Dependency chains from an application to a library
+	g := graph.New[string](graph.KindDirected)
+	g.AddEdge("app", "http")
+	g.AddEdge("app", "log")
+	g.AddEdge("http", "tls")
+	g.AddEdge("log", "tls")
+	g.AddEdge("tls", "crypto")
+	g.AddEdge("http", "crypto")
+	g.AddEdge("crypto", "app")
+
+	paths := make([]string, 0)
+	walkFunc := func(path []string) error {
+		paths = append(paths, fmt.Sprint(path))
+		return nil
+	}
+	if err := graph.WalkAllSimplePaths(g, "app", "crypto", nil, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(paths)
+	want := []string{
+		"[app http crypto]",
+		"[app http tls crypto]",
+		"[app log tls crypto]",
+	}
+	if !slices.Equal(paths, want) {
+		t.Fatalf("want paths %v, got %v", want, paths)
+	}
+
+	// Skip the edges to the logging library
+	opts := graph.DefaultSimplePathOptions[string]()
+	opts.EdgeFilter = func(e *graph.Edge[string]) bool {
+		return e.To != "log"
+	}
+	paths = paths[:0]
+	if err := graph.WalkAllSimplePaths(g, "app", "crypto", opts, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("want 2 paths, got %v", paths)
+	}
+
+	// Stop after the first path
+	count := 0
+	stopFunc := func(path []string) error {
+		count++
+		return graph.ErrStopWalking
+	}
+	if err := graph.WalkAllSimplePaths(g, "app", "crypto", nil, stopFunc); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("want 1 path, got %d", count)
+	}
+
+	errTest := errors.New("test error")
+	errFunc := func(path []string) error {
+		return errTest
+	}
+	if err := graph.WalkAllSimplePaths(g, "app", "crypto", nil, errFunc); !errors.Is(err, errTest) {
+		t.Fatalf("want test error, got %v", err)
+	}
+
+	paths = paths[:0]
+	if err := graph.WalkAllSimplePaths(g, "tls", "tls", nil, walkFunc); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(paths, []string{"[tls]"}) {
+		t.Fatalf("want the single vertex path, got %v", paths)
+	}
+
+	if err := graph.WalkAllSimplePaths(g, "app", "missing", nil, walkFunc); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+}
+
+func TestWalkAllSimplePathsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(44))
+	for iter := 0; iter < 20; iter++ {
+		var g graph.Graph[int]
+		if iter%2 == 0 {
+			g = newRandomDirectedGraph(r, 8, 0.3, false)
+		} else {
+			g = newRandomUndirectedGraph(r, 8, 0.35)
+		}
+
+		for _, limits := range []struct {
+			depth  int
+			length float64
+		}{{-1, -1}, {3, -1}, {-1, 30}, {4, 25}, {0, -1}, {-1, 0}} {
+			opts := graph.DefaultSimplePathOptions[int]()
+			opts.MaxDepth = limits.depth
+			opts.MaxLength = limits.length
+
+			got := make([]string, 0)
+			walkFunc := func(path []int) error {
+				got = append(got, fmt.Sprint(path))
+				return nil
+			}
+			if err := graph.WalkAllSimplePaths(g, 0, 7, opts, walkFunc); err != nil {
+				t.Fatal(err)
+			}
+			slices.Sort(got)
+
+			maxDepth, maxLength := limits.depth, limits.length
+			if maxDepth < 0 {
+				maxDepth = 8
+			}
+			if maxLength < 0 {
+				maxLength = 1000
+			}
+			want := bruteForceSimplePaths(g, 0, 7, maxDepth, maxLength)
+			if !slices.Equal(got, want) {
+				t.Fatalf("want paths %v, got %v", want, got)
+			}
+		}
+	}
+}
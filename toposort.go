@@ -62,6 +62,9 @@ func WalkTopoOrder[T comparable](g Graph[T], walkFunc WalkFunc[T]) error {
 				panic(err)
 			}
 
+			// Descend into a single neighbour at a time, so
+			// that the gray vertices are exactly the ones on
+			// the path from the source to V.
 			isReady := true
 			neighbours := g.GetNeighbourVertices(v.Value)
 			for _, u := range neighbours {
@@ -72,9 +75,10 @@ func WalkTopoOrder[T comparable](g Graph[T], walkFunc WalkFunc[T]) error {
 					u.DistanceFromSource = v.DistanceFromSource + 1
 					u.Parent = v
 					stack.PushFront(u)
+					break
 				} else if u.Color == Gray {
-					// Seen this neighbour before, cycle
-					// has been detected
+					// Neighbour is on the current path,
+					// cycle has been detected
 					return result, ErrCycleDetected
 				}
 			}
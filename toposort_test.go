@@ -77,3 +77,27 @@ func TestWalkTopoOrder(t *testing.T) {
 		t.Fatal("g3: graph should contain a cycle")
 	}
 }
+
+func TestWalkTopoOrderSharedDescendant(t *testing.T) {
+	// Vertex 2 is reachable both directly from 1 and through 3,
+	// which must not be reported as a cycle
+	g := graph.New[int](graph.KindDirected)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(3, 2)
+
+	for i := 0; i < 10; i++ {
+		collector := g.NewCollector()
+		if err := graph.WalkTopoOrder(g, collector.WalkFunc); err != nil {
+			t.Fatal(err)
+		}
+		gotValues := make([]int, 0)
+		for _, v := range collector.Get() {
+			gotValues = append(gotValues, v.Value)
+		}
+		wantValues := []int{2, 3, 1}
+		if !slices.Equal(gotValues, wantValues) {
+			t.Fatalf("want topo order %v, got %v", wantValues, gotValues)
+		}
+	}
+}
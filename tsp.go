@@ -0,0 +1,445 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ErrIsNotCompleteGraph is returned whenever an operation cannot be
+// performed, because the graph is not complete.
+var ErrIsNotCompleteGraph = errors.New("graph is not complete")
+
+// MaxHeldKarpTSPVertices is the maximum number of vertices supported
+// by TSPHeldKarp.
+const MaxHeldKarpTSPVertices = 16
+
+// tspEpsilon is the minimum improvement of a tour, which is
+// considered by the local search heuristics.
+const tspEpsilon = 1.0e-9
+
+// tspDistances returns the matrix of edge weights between each pair
+// of vertex positions. Pairs of vertices which are not connected by
+// an edge have an infinite distance.
+func tspDistances[T comparable](g Graph[T], idx *vertexIndex[T]) [][]float64 {
+	n := len(idx.values)
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			if i != j {
+				dist[i][j] = math.Inf(1)
+			}
+		}
+	}
+
+	for v, arcs := range idx.arcs(g) {
+		for _, arc := range arcs {
+			if arc.to != v {
+				dist[v][arc.to] = arc.weight
+			}
+		}
+	}
+
+	return dist
+}
+
+// tourWeight returns the total weight of the closed tour, given as an
+// open sequence of vertex positions
+func tourWeight(dist [][]float64, tour []int) float64 {
+	total := 0.0
+	for i := range tour {
+		total += dist[tour[i]][tour[(i+1)%len(tour)]]
+	}
+
+	return total
+}
+
+// closeTour converts an open sequence of vertex positions into a
+// closed tour of values, which ends at the vertex it starts from
+func closeTour[T comparable](idx *vertexIndex[T], tour []int) []T {
+	result := trailValues(idx, tour)
+	if len(tour) > 0 {
+		result = append(result, idx.values[tour[0]])
+	}
+
+	return result
+}
+
+// openTour validates the given closed tour of values, and converts it
+// into an open sequence of vertex positions
+func openTour[T comparable](idx *vertexIndex[T], tour []T) ([]int, error) {
+	n := len(idx.values)
+	if n == 0 && len(tour) == 0 {
+		return []int{}, nil
+	}
+	if len(tour) != n+1 || tour[0] != tour[n] {
+		return nil, fmt.Errorf("Tour must visit each of the %d vertices once and return to the start", n)
+	}
+
+	seen := make([]bool, n)
+	result := make([]int, 0, n)
+	for _, v := range tour[:n] {
+		i, ok := idx.index[v]
+		if !ok {
+			return nil, fmt.Errorf("Vertex %v not found in the graph", v)
+		}
+		if seen[i] {
+			return nil, fmt.Errorf("Vertex %v is visited more than once", v)
+		}
+		seen[i] = true
+		result = append(result, i)
+	}
+
+	return result, nil
+}
+
+// TSPHeldKarp returns an optimal tour of the traveling salesman
+// problem, which visits each vertex of the weighted graph exactly
+// once and returns to the start, with minimum total weight. The first
+// and the last vertex of the tour are the same.
+//
+// The algorithm of Held and Karp takes O(2^n * n^2) time and O(2^n *
+// n) memory, and supports graphs with up to MaxHeldKarpTSPVertices
+// vertices. Returns ErrNoHamiltonianCycle, if no tour exists.
+func TSPHeldKarp[T comparable](g Graph[T]) ([]T, float64, error) {
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	if err := checkExactSize(n, MaxHeldKarpTSPVertices); err != nil {
+		return nil, 0, err
+	}
+	if n == 0 {
+		return []T{}, 0, nil
+	}
+	if n == 1 {
+		return closeTour(idx, []int{0}), 0, nil
+	}
+
+	dist := tspDistances(g, idx)
+
+	// cost[mask][v] is the minimum weight of a path starting from
+	// the first vertex, which visits the vertices of the subset and
+	// ends at V.
+	cost := make([][]float64, 1<<n)
+	for mask := range cost {
+		cost[mask] = make([]float64, n)
+		for v := range cost[mask] {
+			cost[mask][v] = math.Inf(1)
+		}
+	}
+	cost[1][0] = 0
+
+	for mask := 1; mask < 1<<n; mask += 2 {
+		for v := 0; v < n; v++ {
+			if mask&(1<<v) == 0 || math.IsInf(cost[mask][v], 1) {
+				continue
+			}
+			for u := 0; u < n; u++ {
+				if mask&(1<<u) != 0 {
+					continue
+				}
+				alt := cost[mask][v] + dist[v][u]
+				if alt < cost[mask|1<<u][u] {
+					cost[mask|1<<u][u] = alt
+				}
+			}
+		}
+	}
+
+	// Close the tour by returning to the first vertex
+	full := 1<<n - 1
+	best := math.Inf(1)
+	end := -1
+	for v := 1; v < n; v++ {
+		alt := cost[full][v] + dist[v][0]
+		if alt < best {
+			best = alt
+			end = v
+		}
+	}
+	if end == -1 {
+		return nil, 0, ErrNoHamiltonianCycle
+	}
+
+	// Walk back through the subsets to reconstruct the tour
+	tour := make([]int, 0, n)
+	mask := full
+	for v := end; v != 0; {
+		tour = append(tour, v)
+		prevMask := mask &^ (1 << v)
+		for u := 0; u < n; u++ {
+			if prevMask&(1<<u) != 0 && cost[prevMask][u]+dist[u][v] == cost[mask][v] {
+				mask = prevMask
+				v = u
+				break
+			}
+		}
+	}
+	tour = append(tour, 0)
+	slices.Reverse(tour)
+
+	return closeTour(idx, tour), best, nil
+}
+
+// TSPNearestNeighbour returns a tour of the traveling salesman
+// problem, which is constructed by repeatedly moving to the nearest
+// unvisited vertex, starting from the given vertex. The first and the
+// last vertex of the tour are the same.
+//
+// Returns ErrNoHamiltonianCycle, if the heuristic gets stuck at a
+// vertex without edges to unvisited vertices, or without an edge
+// back to the start.
+func TSPNearestNeighbour[T comparable](g Graph[T], start T) ([]T, float64, error) {
+	if !g.VertexExists(start) {
+		return nil, 0, fmt.Errorf("Source vertex %v not found in the graph", start)
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	dist := tspDistances(g, idx)
+
+	visited := make([]bool, n)
+	v := idx.index[start]
+	visited[v] = true
+	tour := []int{v}
+	for len(tour) < n {
+		next := -1
+		for u := 0; u < n; u++ {
+			if !visited[u] && !math.IsInf(dist[v][u], 1) && (next == -1 || dist[v][u] < dist[v][next]) {
+				next = u
+			}
+		}
+		if next == -1 {
+			return nil, 0, fmt.Errorf("%w: no unvisited vertex is reachable from %v", ErrNoHamiltonianCycle, idx.values[v])
+		}
+
+		visited[next] = true
+		tour = append(tour, next)
+		v = next
+	}
+
+	weight := tourWeight(dist, tour)
+	if math.IsInf(weight, 1) {
+		return nil, 0, fmt.Errorf("%w: no edge from %v back to %v", ErrNoHamiltonianCycle, idx.values[v], start)
+	}
+
+	return closeTour(idx, tour), weight, nil
+}
+
+// twoOptDelta returns the change in the weight of the tour, if the
+// segment between positions I and J is reversed. In undirected graphs
+// only the two edges at the ends of the segment change, while in
+// directed graphs each edge of the segment is traversed backwards.
+func twoOptDelta(dist [][]float64, tour []int, i, j int, directed bool) float64 {
+	a, b := tour[i-1], tour[i]
+	c, d := tour[j], tour[(j+1)%len(tour)]
+	delta := dist[a][c] + dist[b][d] - dist[a][b] - dist[c][d]
+	if directed {
+		for k := i; k < j; k++ {
+			delta += dist[tour[k+1]][tour[k]] - dist[tour[k]][tour[k+1]]
+		}
+	}
+
+	return delta
+}
+
+// TSPTwoOpt improves the given tour of the traveling salesman problem
+// using the 2-opt local search heuristic, which repeatedly reverses a
+// segment of the tour, as long as this reduces its total weight. The
+// tour must start and end at the same vertex, and visit each other
+// vertex exactly once.
+func TSPTwoOpt[T comparable](g Graph[T], tour []T) ([]T, float64, error) {
+	idx := newVertexIndex(g)
+	positions, err := openTour(idx, tour)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dist := tspDistances(g, idx)
+	directed := g.Kind() == KindDirected
+	n := len(positions)
+
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-1; i++ {
+			for j := i + 1; j < n; j++ {
+				// Reverse the segment between positions
+				// I and J, keeping the start in place
+				if twoOptDelta(dist, positions, i, j, directed) < -tspEpsilon {
+					slices.Reverse(positions[i : j+1])
+					improved = true
+				}
+			}
+		}
+	}
+
+	return closeTour(idx, positions), tourWeight(dist, positions), nil
+}
+
+// TSPOrOpt improves the given tour of the traveling salesman problem
+// using the Or-opt local search heuristic, which repeatedly moves a
+// segment of up to three consecutive vertices to another position of
+// the tour, as long as this reduces its total weight. The tour must
+// start and end at the same vertex, and visit each other vertex
+// exactly once.
+func TSPOrOpt[T comparable](g Graph[T], tour []T) ([]T, float64, error) {
+	idx := newVertexIndex(g)
+	positions, err := openTour(idx, tour)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dist := tspDistances(g, idx)
+	n := len(positions)
+
+	for improved := true; improved; {
+		improved = false
+		for length := 1; length <= 3; length++ {
+			// Keep the start of the tour in place
+			for i := 1; i+length <= n; i++ {
+				// The segment is removed from between A and
+				// B, and inserted between X and Y, which are
+				// the vertices at positions J-1 and J of the
+				// tour without the segment.
+				first, last := positions[i], positions[i+length-1]
+				a, b := positions[i-1], positions[(i+length)%n]
+				removed := dist[a][b] - dist[a][first] - dist[last][b]
+				rest := func(k int) int {
+					if k < i {
+						return positions[k]
+					}
+					return positions[(k+length)%n]
+				}
+				for j := 1; j <= n-length; j++ {
+					if j == i {
+						continue
+					}
+					x, y := rest(j-1), rest(j)
+					delta := removed + dist[x][first] + dist[last][y] - dist[x][y]
+					if delta < -tspEpsilon {
+						candidate := make([]int, 0, n)
+						candidate = append(candidate, positions[:i]...)
+						candidate = append(candidate, positions[i+length:]...)
+						candidate = slices.Insert(candidate, j, positions[i:i+length]...)
+						positions = candidate
+						improved = true
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return closeTour(idx, positions), tourWeight(dist, positions), nil
+}
+
+// TSPChristofides returns a tour of the traveling salesman problem
+// for a complete undirected graph, whose edge weights satisfy the
+// triangle inequality, using the algorithm of Christofides. The
+// weight of the resulting tour is at most 3/2 times the optimum.
+//
+// The tour is constructed from an Eulerian circuit of the minimum
+// spanning tree combined with a minimum weight perfect matching of
+// the vertices with odd degree in the tree, by skipping repeated
+// vertices.
+func TSPChristofides[T comparable](g Graph[T]) ([]T, float64, error) {
+	if g.Kind() != KindUndirected {
+		return nil, 0, ErrIsNotUndirectedGraph
+	}
+	if err := validateWeights(g); err != nil {
+		return nil, 0, err
+	}
+
+	idx := newVertexIndex(g)
+	n := len(idx.values)
+	dist := tspDistances(g, idx)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if math.IsInf(dist[i][j], 1) {
+				return nil, 0, fmt.Errorf("%w: no edge between %v and %v", ErrIsNotCompleteGraph, idx.values[i], idx.values[j])
+			}
+		}
+	}
+	if n < 3 {
+		tour := make([]int, n)
+		for i := range tour {
+			tour[i] = i
+		}
+		return closeTour(idx, tour), tourWeight(dist, tour), nil
+	}
+
+	tree, err := MinimumSpanningTree(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Find a minimum weight perfect matching of the vertices with
+	// odd degree, by maximizing the complementary weights among
+	// the matchings of maximum cardinality
+	odd := make([]T, 0)
+	maxWeight := 0.0
+	for _, v := range tree.GetVertices() {
+		if v.Degree.Out%2 != 0 {
+			odd = append(odd, v.Value)
+		}
+	}
+	for _, e := range g.GetEdges() {
+		maxWeight = max(maxWeight, e.Weight)
+	}
+	oddGraph := New[T](KindUndirected)
+	for i, u := range odd {
+		for _, v := range odd[i+1:] {
+			oddGraph.AddWeightedEdge(u, v, maxWeight+1-dist[idx.index[u]][idx.index[v]])
+		}
+	}
+	matching, err := MaxWeightMatching(oddGraph, true)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The union of the tree and the matching forms a multigraph,
+	// where each vertex has an even degree
+	edges := idx.edges(tree)
+	for _, e := range matching {
+		edges = append(edges, [2]int{idx.index[e.From], idx.index[e.To]})
+	}
+	circuit := hierholzer(n, edges, false, 0)
+
+	// Skip repeated vertices to obtain a Hamiltonian cycle
+	visited := make([]bool, n)
+	tour := make([]int, 0, n)
+	for _, v := range circuit {
+		if !visited[v] {
+			visited[v] = true
+			tour = append(tour, v)
+		}
+	}
+
+	return closeTour(idx, tour), tourWeight(dist, tour), nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Marin Atanasov Nikolov <dnaeon@gmail.com>
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions
+// are met:
+//
+//   1. Redistributions of source code must retain the above copyright
+//      notice, this list of conditions and the following disclaimer.
+//   2. Redistributions in binary form must reproduce the above copyright
+//      notice, this list of conditions and the following disclaimer in the
+//      documentation and/or other materials provided with the distribution.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+// AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+// IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE
+// ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE
+// LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR
+// CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF
+// SUBSTITUTE GOODS OR SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS
+// INTERRUPTION) HOWEVER CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN
+// CONTRACT, STRICT LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE)
+// ARISING IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+// POSSIBILITY OF SUCH DAMAGE.
+
+package graph_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gopkg.in/dnaeon/go-graph.v1"
+)
+
+// A helper function which creates a complete graph over random points
+// in the plane, weighted by the euclidean distance
+func newRandomEuclideanGraph(r *rand.Rand, n int) graph.Graph[int] {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = r.Float64() * 100
+		y[i] = r.Float64() * 100
+	}
+
+	g := graph.New[int](graph.KindUndirected)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			g.AddWeightedEdge(i, j, math.Hypot(x[i]-x[j], y[i]-y[j]))
+		}
+	}
+
+	return g
+}
+
+// A helper function which verifies that the given tour visits each
+// vertex exactly once, and has the given total weight
+func verifyTour[T comparable](t *testing.T, g graph.Graph[T], tour []T, weight float64) {
+	if len(tour) == 0 || tour[0] != tour[len(tour)-1] {
+		t.Fatalf("want closed tour, got %v", tour)
+	}
+	verifyHamiltonianPath(t, g, tour[:len(tour)-1])
+
+	total := 0.0
+	for i := 0; i+1 < len(tour); i++ {
+		e := g.GetEdge(tour[i], tour[i+1])
+		if e == nil {
+			t.Fatalf("no edge between %v and %v", tour[i], tour[i+1])
+		}
+		total += e.Weight
+	}
+	if math.Abs(total-weight) > 1e-9 {
+		t.Fatalf("want tour weight %v, got %v", total, weight)
+	}
+}
+
+func TestTSPHeldKarp(t *testing.T) {
+	g := graph.New[string](graph.KindUndirected)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("b", "c", 1)
+	g.AddWeightedEdge("c", "d", 1)
+	g.AddWeightedEdge("d", "a", 1)
+	g.AddWeightedEdge("a", "c", 5)
+	g.AddWeightedEdge("b", "d", 5)
+
+	tour, weight, err := graph.TSPHeldKarp(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyTour(t, g, tour, weight)
+	if weight != 4 {
+		t.Fatalf("want tour weight 4, got %v", weight)
+	}
+
+	// Directed tours must follow the direction of the edges
+	d := graph.New[int](graph.KindDirected)
+	d.AddWeightedEdge(1, 2, 1)
+	d.AddWeightedEdge(2, 3, 1)
+	d.AddWeightedEdge(3, 1, 1)
+	d.AddWeightedEdge(1, 3, 10)
+	d.AddWeightedEdge(3, 2, 10)
+	d.AddWeightedEdge(2, 1, 10)
+	cycle, weight, err := graph.TSPHeldKarp(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyTour(t, d, cycle, weight)
+	if weight != 3 {
+		t.Fatalf("want tour weight 3, got %v", weight)
+	}
+
+	// Star graph has no tour
+	star := graph.New[int](graph.KindUndirected)
+	star.AddEdge(1, 2)
+	star.AddEdge(1, 3)
+	star.AddEdge(1, 4)
+	if _, _, err := graph.TSPHeldKarp(star); !errors.Is(err, graph.ErrNoHamiltonianCycle) {
+		t.Fatalf("want ErrNoHamiltonianCycle, got %v", err)
+	}
+}
+
+func TestTSPHeuristics(t *testing.T) {
+	r := rand.New(rand.NewSource(36))
+	for iter := 0; iter < 20; iter++ {
+		g := newRandomEuclideanGraph(r, 9)
+		_, optimum, err := graph.TSPHeldKarp(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tour, weight, err := graph.TSPNearestNeighbour(g, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyTour(t, g, tour, weight)
+		if tour[0] != 0 {
+			t.Fatalf("want tour starting at 0, got %v", tour)
+		}
+
+		improved, improvedWeight, err := graph.TSPTwoOpt(g, tour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyTour(t, g, improved, improvedWeight)
+		if improvedWeight > weight+1e-9 || improvedWeight < optimum-1e-9 {
+			t.Fatalf("want 2-opt tour weight in [%v, %v], got %v", optimum, weight, improvedWeight)
+		}
+
+		improved, improvedWeight, err = graph.TSPOrOpt(g, tour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyTour(t, g, improved, improvedWeight)
+		if improvedWeight > weight+1e-9 || improvedWeight < optimum-1e-9 {
+			t.Fatalf("want Or-opt tour weight in [%v, %v], got %v", optimum, weight, improvedWeight)
+		}
+
+		tour, weight, err = graph.TSPChristofides(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifyTour(t, g, tour, weight)
+		if weight > 1.5*optimum+1e-9 || weight < optimum-1e-9 {
+			t.Fatalf("want Christofides tour weight in [%v, %v], got %v", optimum, 1.5*optimum, weight)
+		}
+	}
+}
+
+func TestTSPErrors(t *testing.T) {
+	g := graph.New[int](graph.KindUndirected)
+	g.AddWeightedEdge(1, 2, 1)
+	g.AddWeightedEdge(2, 3, 1)
+
+	if _, _, err := graph.TSPChristofides(g); !errors.Is(err, graph.ErrIsNotCompleteGraph) {
+		t.Fatalf("want ErrIsNotCompleteGraph, got %v", err)
+	}
+	if _, _, err := graph.TSPNearestNeighbour(g, 1); !errors.Is(err, graph.ErrNoHamiltonianCycle) {
+		t.Fatalf("want ErrNoHamiltonianCycle, got %v", err)
+	}
+	if _, _, err := graph.TSPNearestNeighbour(g, 4); err == nil {
+		t.Fatal("want error for missing vertex")
+	}
+	if _, _, err := graph.TSPTwoOpt(g, []int{1, 2, 1}); err == nil {
+		t.Fatal("want error for incomplete tour")
+	}
+	if _, _, err := graph.TSPChristofides(graph.New[int](graph.KindDirected)); !errors.Is(err, graph.ErrIsNotUndirectedGraph) {
+		t.Fatalf("want ErrIsNotUndirectedGraph, got %v", err)
+	}
+}